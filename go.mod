@@ -4,5 +4,8 @@ go 1.25.5
 
 require (
 	github.com/gorilla/websocket v1.5.3
+	golang.org/x/crypto v0.47.0
 	golang.org/x/net v0.49.0
 )
+
+require golang.org/x/sys v0.40.0 // indirect
@@ -0,0 +1,24 @@
+package shared
+
+import (
+	"net/http"
+	"time"
+)
+
+// CacheEntry はキャッシュされたレスポンスです
+type CacheEntry struct {
+	Body      []byte
+	Header    http.Header
+	ETag      string
+	ExpiresAt time.Time
+}
+
+// Cache はレスポンスキャッシュのバックエンドです。
+// キーは "GET /worlds/wrld_xxx" のようなメソッドとパスに、クライアントごとの名前空間を前置して作られます。
+// そのため同じ Cache を複数のクライアントで共有しても、別のユーザーのレスポンスが返されることはありません。
+// 実装は複数のゴルーチンから同時に呼び出されても安全である必要があります。
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
@@ -0,0 +1,114 @@
+package shared
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// icalTimeFormat は iCalendar の UTC 日時の形式です
+	icalTimeFormat = "20060102T150405Z"
+	// icalLineLimit は iCalendar の1行の最大オクテット数です
+	icalLineLimit = 75
+)
+
+// WriteICalendar はカレンダーイベントを iCalendar（RFC 5545, .ics）形式で w に書き込みます。
+// 開始日時を解析できないイベントがある場合は何も書き込まずにエラーを返します。
+func WriteICalendar(w io.Writer, events []CalendarEvent) error {
+	type icalTimes struct {
+		start, end, stamp time.Time
+	}
+	times := make([]icalTimes, len(events))
+	for i, event := range events {
+		start, err := event.StartTime()
+		if err != nil {
+			return fmt.Errorf("failed to parse start time of event %s: %w", event.ID, err)
+		}
+		t := icalTimes{start: start, stamp: start}
+		// 終了日時がない場合は DTEND を省略する
+		if end, err := event.EndTime(); err == nil {
+			t.end = end
+		}
+		if updated, err := time.Parse(time.RFC3339, event.UpdatedAt); err == nil {
+			t.stamp = updated
+		}
+		times[i] = t
+	}
+
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeICalLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//kqnade//vrcgo//EN")
+	line("CALSCALE", "GREGORIAN")
+	for i, event := range events {
+		t := times[i]
+		line("BEGIN", "VEVENT")
+		line("UID", event.ID+"@vrchat.com")
+		line("DTSTAMP", t.stamp.UTC().Format(icalTimeFormat))
+		line("DTSTART", t.start.UTC().Format(icalTimeFormat))
+		if !t.end.IsZero() {
+			line("DTEND", t.end.UTC().Format(icalTimeFormat))
+		}
+		line("SUMMARY", escapeICalText(event.Title))
+		if event.Description != "" {
+			line("DESCRIPTION", escapeICalText(event.Description))
+		}
+		if event.Category != "" {
+			line("CATEGORIES", escapeICalText(string(event.Category)))
+		}
+		if event.OwnerID != "" {
+			line("URL", "https://vrchat.com/home/group/"+event.OwnerID+"/calendar/"+event.ID)
+		}
+		if event.AccessType == CalendarEventAccessGroup {
+			line("CLASS", "PRIVATE")
+		} else {
+			line("CLASS", "PUBLIC")
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeICalText は iCalendar の TEXT 値をエスケープします
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeICalLine は1行を75オクテットごとに折り返して CRLF 区切りで書き込みます。
+// 折り返しは UTF-8 の文字の途中では行いません。
+func writeICalLine(w *bufio.Writer, s string) {
+	limit := icalLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !isUTF8Start(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		// 継続行は先頭の空白を含めて75オクテット
+		limit = icalLineLimit - 1
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
+
+// isUTF8Start は b が UTF-8 の文字の先頭バイトかを判定します
+func isUTF8Start(b byte) bool {
+	return b&0xC0 != 0x80
+}
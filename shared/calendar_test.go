@@ -0,0 +1,143 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWriteICalendarGolden(t *testing.T) {
+	events := []CalendarEvent{
+		{
+			ID:          "cal_1",
+			OwnerID:     "grp_1",
+			Title:       "【定期開催】週末まったり交流会〜初心者歓迎！ワールド巡りとおしゃべりを楽しみましょう（毎週土曜日の夜に開催、途中参加・途中退出も大歓迎です）",
+			Description: "集合場所: ホーム; 持ち物なし, 気軽にどうぞ\n詳細は C:\\events を参照",
+			StartsAt:    "2026-03-07T20:00:00+09:00",
+			EndsAt:      "2026-03-07T22:00:00+09:00",
+			Category:    CalendarEventCategoryHangout,
+			AccessType:  CalendarEventAccessPublic,
+			UpdatedAt:   "2026-03-01T09:00:00Z",
+		},
+		{
+			// 終了日時・説明・カテゴリがなく、更新日時がない場合は開始日時を DTSTAMP に使う
+			ID:         "cal_2",
+			OwnerID:    "grp_2",
+			Title:      "Members only, short",
+			StartsAt:   "2026-03-10T12:00:00.000Z",
+			AccessType: CalendarEventAccessGroup,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteICalendar(&buf, events); err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "calendar.ics"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("WriteICalendar() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteICalendarInvalidStart(t *testing.T) {
+	events := []CalendarEvent{
+		{ID: "cal_1", Title: "OK", StartsAt: "2026-03-07T20:00:00Z"},
+		{ID: "cal_2", Title: "Broken", StartsAt: ""},
+	}
+	var buf bytes.Buffer
+	err := WriteICalendar(&buf, events)
+	if err == nil || !strings.Contains(err.Error(), "cal_2") {
+		t.Errorf("WriteICalendar() error = %v, want error for cal_2", err)
+	}
+	// 途中までの出力は書き込まない
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes before failing", buf.Len())
+	}
+}
+
+func TestCalendarEventTimes(t *testing.T) {
+	event := CalendarEvent{StartsAt: "2026-03-07T11:00:00.000Z", EndsAt: "2026-03-07T22:00:00+09:00"}
+	start, err := event.StartTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := event.EndTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !start.Equal(time.Date(2026, 3, 7, 11, 0, 0, 0, time.UTC)) || end.Sub(start) != 2*time.Hour {
+		t.Errorf("StartTime() = %v, EndTime() = %v", start, end)
+	}
+	if _, err := (CalendarEvent{}).EndTime(); err == nil {
+		t.Error("EndTime() of empty event succeeded, want error")
+	}
+}
+
+func TestWriteICalLineFolding(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{name: "short", line: "SUMMARY:hello"},
+		{name: "exactly 75 octets", line: "SUMMARY:" + strings.Repeat("a", 67)},
+		{name: "ascii", line: "DESCRIPTION:" + strings.Repeat("abcdefghij", 20)},
+		// 3バイト文字が折り返し位置をまたぐ
+		{name: "multibyte", line: "SUMMARY:" + strings.Repeat("あいうえお", 20)},
+		// 4バイト文字（絵文字）
+		{name: "emoji", line: "SUMMARY:x" + strings.Repeat("🎉", 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			writeICalLine(w, tt.line)
+			w.Flush()
+			out := buf.String()
+
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output %q does not end with CRLF", out)
+			}
+			physical := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			for i, line := range physical {
+				if len(line) > icalLineLimit {
+					t.Errorf("line %d has %d octets, want <= %d", i, len(line), icalLineLimit)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 character: %q", i, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, line)
+				}
+			}
+			// 折り返しを戻すと元の行になる（RFC 5545 3.1）
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("unfolded = %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestEscapeICalText(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "plain", want: "plain"},
+		{in: `a\b`, want: `a\\b`},
+		{in: "a;b,c", want: `a\;b\,c`},
+		{in: "line1\r\nline2\nline3\rline4", want: `line1\nline2\nline3\nline4`},
+		{in: "日本語: テスト", want: "日本語: テスト"},
+	}
+	for _, tt := range tests {
+		if got := escapeICalText(tt.in); got != tt.want {
+			t.Errorf("escapeICalText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -1,12 +1,18 @@
 package shared
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // APIError はVRChat API固有のエラーです
 type APIError struct {
 	StatusCode int
 	Message    string
 	ErrorCode  string
+	// RetryAfter はレスポンスの Retry-After ヘッダーが示す待機時間です（指定がない場合は0）
+	RetryAfter time.Duration
 }
 
 func (e *APIError) Error() string {
@@ -20,18 +26,30 @@ func (e *APIError) Error() string {
 
 // IsAuthenticationError は認証エラーかどうかを判定します
 func IsAuthenticationError(err error) bool {
-	apiErr, ok := err.(*APIError)
-	return ok && apiErr.StatusCode == 401
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 401
 }
 
 // IsRateLimitError はレート制限エラーかどうかを判定します
 func IsRateLimitError(err error) bool {
-	apiErr, ok := err.(*APIError)
-	return ok && apiErr.StatusCode == 429
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 429
 }
 
 // IsNotFoundError はリソースが見つからないエラーかどうかを判定します
 func IsNotFoundError(err error) bool {
-	apiErr, ok := err.(*APIError)
-	return ok && apiErr.StatusCode == 404
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 404
+}
+
+// InviteMessageCooldownError は招待メッセージの更新クールダウン中であることを示すエラーです
+type InviteMessageCooldownError struct {
+	MessageType InviteMessageType
+	Slot        int
+	Remaining   time.Duration
+}
+
+func (e *InviteMessageCooldownError) Error() string {
+	return fmt.Sprintf("invite message %s slot %d cannot be updated for another %s",
+		e.MessageType, e.Slot, e.Remaining)
 }
@@ -0,0 +1,577 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// knownFieldsCache は型ごとの既知のJSONフィールド名をキャッシュします
+var knownFieldsCache sync.Map // map[reflect.Type]map[string]struct{}
+
+// knownFields は構造体型が扱うJSONフィールド名の集合を返します。
+// encoding/json と同様に、タグで名前を付けていない埋め込み構造体のフィールドも含みます。
+func knownFields(t reflect.Type) map[string]struct{} {
+	if cached, ok := knownFieldsCache.Load(t); ok {
+		return cached.(map[string]struct{})
+	}
+
+	fields := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for embedded := range knownFields(ft) {
+					fields[embedded] = struct{}{}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if !hasTag || name == "" {
+			name = f.Name
+		}
+		fields[name] = struct{}{}
+	}
+
+	knownFieldsCache.Store(t, fields)
+	return fields
+}
+
+// unmarshalWithExtra は data を v にデコードし、v が扱わないフィールドを返します。
+// v は構造体へのポインタである必要があります。
+func unmarshalWithExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(reflect.TypeOf(v).Elem())
+	var extra map[string]json.RawMessage
+	for key, value := range raw {
+		if _, ok := known[key]; ok {
+			continue
+		}
+		// encoding/json と同様に大文字小文字を区別しない一致も既知として扱う
+		if hasFoldedKey(known, key) {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]json.RawMessage)
+		}
+		extra[key] = value
+	}
+	return extra, nil
+}
+
+// hasFoldedKey は大文字小文字を無視して一致するキーがあるかを判定します
+func hasFoldedKey(known map[string]struct{}, key string) bool {
+	for k := range known {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalWithExtra は v をエンコードし、extra のうち v が扱わないフィールドを追記します
+func marshalWithExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	known := knownFields(reflect.TypeOf(v))
+	keys := make([]string, 0, len(extra))
+	for key := range extra {
+		if _, ok := known[key]; ok {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return data, nil
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for i, key := range keys {
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value := extra[key]
+		if len(value) == 0 {
+			value = json.RawMessage("null")
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (u *CurrentUser) UnmarshalJSON(data []byte) error {
+	type alias CurrentUser
+	extra, err := unmarshalWithExtra(data, (*alias)(u))
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (u CurrentUser) MarshalJSON() ([]byte, error) {
+	type alias CurrentUser
+	return marshalWithExtra(alias(u), u.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (u *User) UnmarshalJSON(data []byte) error {
+	type alias User
+	extra, err := unmarshalWithExtra(data, (*alias)(u))
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (u User) MarshalJSON() ([]byte, error) {
+	type alias User
+	return marshalWithExtra(alias(u), u.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (u *LimitedUser) UnmarshalJSON(data []byte) error {
+	type alias LimitedUser
+	extra, err := unmarshalWithExtra(data, (*alias)(u))
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (u LimitedUser) MarshalJSON() ([]byte, error) {
+	type alias LimitedUser
+	return marshalWithExtra(alias(u), u.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (g *UserGroup) UnmarshalJSON(data []byte) error {
+	type alias UserGroup
+	extra, err := unmarshalWithExtra(data, (*alias)(g))
+	if err != nil {
+		return err
+	}
+	g.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (g UserGroup) MarshalJSON() ([]byte, error) {
+	type alias UserGroup
+	return marshalWithExtra(alias(g), g.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (a *Avatar) UnmarshalJSON(data []byte) error {
+	type alias Avatar
+	extra, err := unmarshalWithExtra(data, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (a Avatar) MarshalJSON() ([]byte, error) {
+	type alias Avatar
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (w *World) UnmarshalJSON(data []byte) error {
+	type alias World
+	extra, err := unmarshalWithExtra(data, (*alias)(w))
+	if err != nil {
+		return err
+	}
+	w.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (w World) MarshalJSON() ([]byte, error) {
+	type alias World
+	return marshalWithExtra(alias(w), w.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (w *LimitedWorld) UnmarshalJSON(data []byte) error {
+	type alias LimitedWorld
+	extra, err := unmarshalWithExtra(data, (*alias)(w))
+	if err != nil {
+		return err
+	}
+	w.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (w LimitedWorld) MarshalJSON() ([]byte, error) {
+	type alias LimitedWorld
+	return marshalWithExtra(alias(w), w.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (i *Instance) UnmarshalJSON(data []byte) error {
+	type alias Instance
+	extra, err := unmarshalWithExtra(data, (*alias)(i))
+	if err != nil {
+		return err
+	}
+	i.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (i Instance) MarshalJSON() ([]byte, error) {
+	type alias Instance
+	return marshalWithExtra(alias(i), i.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	type alias Notification
+	extra, err := unmarshalWithExtra(data, (*alias)(n))
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (n Notification) MarshalJSON() ([]byte, error) {
+	type alias Notification
+	return marshalWithExtra(alias(n), n.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (f *Favorite) UnmarshalJSON(data []byte) error {
+	type alias Favorite
+	extra, err := unmarshalWithExtra(data, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (f Favorite) MarshalJSON() ([]byte, error) {
+	type alias Favorite
+	return marshalWithExtra(alias(f), f.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (g *FavoriteGroup) UnmarshalJSON(data []byte) error {
+	type alias FavoriteGroup
+	extra, err := unmarshalWithExtra(data, (*alias)(g))
+	if err != nil {
+		return err
+	}
+	g.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (g FavoriteGroup) MarshalJSON() ([]byte, error) {
+	type alias FavoriteGroup
+	return marshalWithExtra(alias(g), g.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (g *Group) UnmarshalJSON(data []byte) error {
+	type alias Group
+	extra, err := unmarshalWithExtra(data, (*alias)(g))
+	if err != nil {
+		return err
+	}
+	g.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (g Group) MarshalJSON() ([]byte, error) {
+	type alias Group
+	return marshalWithExtra(alias(g), g.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (m *GroupMember) UnmarshalJSON(data []byte) error {
+	type alias GroupMember
+	extra, err := unmarshalWithExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (m GroupMember) MarshalJSON() ([]byte, error) {
+	type alias GroupMember
+	return marshalWithExtra(alias(m), m.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (a *GroupAnnouncement) UnmarshalJSON(data []byte) error {
+	type alias GroupAnnouncement
+	extra, err := unmarshalWithExtra(data, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (a GroupAnnouncement) MarshalJSON() ([]byte, error) {
+	type alias GroupAnnouncement
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (f *File) UnmarshalJSON(data []byte) error {
+	type alias File
+	extra, err := unmarshalWithExtra(data, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (f File) MarshalJSON() ([]byte, error) {
+	type alias File
+	return marshalWithExtra(alias(f), f.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (m *PlayerModeration) UnmarshalJSON(data []byte) error {
+	type alias PlayerModeration
+	extra, err := unmarshalWithExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (m PlayerModeration) MarshalJSON() ([]byte, error) {
+	type alias PlayerModeration
+	return marshalWithExtra(alias(m), m.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (c *Config) UnmarshalJSON(data []byte) error {
+	type alias Config
+	extra, err := unmarshalWithExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (c Config) MarshalJSON() ([]byte, error) {
+	type alias Config
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (p *InfoPush) UnmarshalJSON(data []byte) error {
+	type alias InfoPush
+	extra, err := unmarshalWithExtra(data, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (p InfoPush) MarshalJSON() ([]byte, error) {
+	type alias InfoPush
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (r *GroupRole) UnmarshalJSON(data []byte) error {
+	type alias GroupRole
+	extra, err := unmarshalWithExtra(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (r GroupRole) MarshalJSON() ([]byte, error) {
+	type alias GroupRole
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (e *GroupAuditLogEntry) UnmarshalJSON(data []byte) error {
+	type alias GroupAuditLogEntry
+	extra, err := unmarshalWithExtra(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (e GroupAuditLogEntry) MarshalJSON() ([]byte, error) {
+	type alias GroupAuditLogEntry
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (p *GroupPost) UnmarshalJSON(data []byte) error {
+	type alias GroupPost
+	extra, err := unmarshalWithExtra(data, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (p GroupPost) MarshalJSON() ([]byte, error) {
+	type alias GroupPost
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (e *CalendarEvent) UnmarshalJSON(data []byte) error {
+	type alias CalendarEvent
+	extra, err := unmarshalWithExtra(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (e CalendarEvent) MarshalJSON() ([]byte, error) {
+	type alias CalendarEvent
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (p *Print) UnmarshalJSON(data []byte) error {
+	type alias Print
+	extra, err := unmarshalWithExtra(data, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (p Print) MarshalJSON() ([]byte, error) {
+	type alias Print
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (i *InventoryItem) UnmarshalJSON(data []byte) error {
+	type alias InventoryItem
+	extra, err := unmarshalWithExtra(data, (*alias)(i))
+	if err != nil {
+		return err
+	}
+	i.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (i InventoryItem) MarshalJSON() ([]byte, error) {
+	type alias InventoryItem
+	return marshalWithExtra(alias(i), i.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (l *ProductListing) UnmarshalJSON(data []byte) error {
+	type alias ProductListing
+	extra, err := unmarshalWithExtra(data, (*alias)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (l ProductListing) MarshalJSON() ([]byte, error) {
+	type alias ProductListing
+	return marshalWithExtra(alias(l), l.Extra)
+}
+
+// UnmarshalJSON は未知のフィールドを Extra に保持してデコードします
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type alias Transaction
+	extra, err := unmarshalWithExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON は Extra のフィールドを含めてエンコードします
+func (t Transaction) MarshalJSON() ([]byte, error) {
+	type alias Transaction
+	return marshalWithExtra(alias(t), t.Extra)
+}
@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type extraTestBase struct {
+	URL string `json:"url"`
+	MD5 string `json:"md5"`
+}
+
+// extraTestModel は埋め込み構造体を持つ Extra 付きのモデルです
+type extraTestModel struct {
+	extraTestBase
+	Name string `json:"name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (m *extraTestModel) UnmarshalJSON(data []byte) error {
+	type alias extraTestModel
+	extra, err := unmarshalWithExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+func (m extraTestModel) MarshalJSON() ([]byte, error) {
+	type alias extraTestModel
+	return marshalWithExtra(alias(m), m.Extra)
+}
+
+// topLevelKeys はJSONオブジェクトの最上位のキーを出現順に返します（重複も含む）
+func topLevelKeys(t *testing.T, data []byte) []string {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, tok.(string))
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+func TestExtraRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		model     func() interface{}
+		wantExtra []string
+	}{
+		{
+			name:      "world",
+			input:     `{"id":"wrld_1","name":"Test","capacity":16,"futureField":{"a":[1,2]},"anotherNew":"x"}`,
+			model:     func() interface{} { return &World{} },
+			wantExtra: []string{"anotherNew", "futureField"},
+		},
+		{
+			name:      "embedded struct",
+			input:     `{"url":"https://example.com","md5":"abc","name":"n","newField":true}`,
+			model:     func() interface{} { return &extraTestModel{} },
+			wantExtra: []string{"newField"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.model()
+			if err := json.Unmarshal([]byte(tt.input), v); err != nil {
+				t.Fatal(err)
+			}
+			extra := reflect.ValueOf(v).Elem().FieldByName("Extra").Interface().(map[string]json.RawMessage)
+			var gotExtra []string
+			for key := range extra {
+				gotExtra = append(gotExtra, key)
+			}
+			slices.Sort(gotExtra)
+			if !slices.Equal(gotExtra, tt.wantExtra) {
+				t.Errorf("Extra keys = %v, want %v", gotExtra, tt.wantExtra)
+			}
+
+			out, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// 各キーは1回だけ出力される
+			seen := make(map[string]bool)
+			for _, key := range topLevelKeys(t, out) {
+				if seen[key] {
+					t.Errorf("key %q is emitted twice in %s", key, out)
+				}
+				seen[key] = true
+			}
+
+			// 入力のすべてのフィールドが同じ値で再出力される
+			var in, got map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.input), &in); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			for key, want := range in {
+				if !reflect.DeepEqual(got[key], want) {
+					t.Errorf("field %q = %v, want %v", key, got[key], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FavoritesBackupVersion はお気に入りバックアップの形式のバージョンです
+const FavoritesBackupVersion = 1
+
+// FavoritesBackup はお気に入りグループとその中身のバックアップです。
+// JSON にエンコードして保存し、同じアカウントまたは別のアカウントに復元できます。
+type FavoritesBackup struct {
+	Version    int                   `json:"version"`
+	ExportedAt time.Time             `json:"exportedAt"`
+	OwnerID    string                `json:"ownerId"`
+	Groups     []FavoriteGroupBackup `json:"groups"`
+}
+
+// FavoriteGroupBackup はお気に入りグループ1つ分のバックアップです
+type FavoriteGroupBackup struct {
+	Type        FavoriteType            `json:"type"`
+	Name        string                  `json:"name"`
+	DisplayName string                  `json:"displayName"`
+	Visibility  FavoriteGroupVisibility `json:"visibility"`
+	// FavoriteIDs はグループ内のワールド・アバター・ユーザーのIDです
+	FavoriteIDs []string `json:"favoriteIds"`
+}
+
+// ParseFavoritesBackup は JSON からお気に入りバックアップを読み込み、バージョンを検証します
+func ParseFavoritesBackup(data []byte) (*FavoritesBackup, error) {
+	var backup FavoritesBackup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, fmt.Errorf("failed to parse favorites backup: %w", err)
+	}
+	if backup.Version < 1 || backup.Version > FavoritesBackupVersion {
+		return nil, fmt.Errorf("unsupported favorites backup version %d (supported: 1-%d)", backup.Version, FavoritesBackupVersion)
+	}
+	return &backup, nil
+}
+
+// RestoreFavoritesOptions はお気に入り復元のオプションです
+type RestoreFavoritesOptions struct {
+	// RestoreGroupSettings が true の場合はグループの表示名と公開範囲も復元します
+	RestoreGroupSettings bool
+	// SkipAvailabilityCheck が true の場合は、ワールド・アバターが存在し公開されているかの確認を省略します
+	SkipAvailabilityCheck bool
+	// DryRun が true の場合は変更を行わず、復元される内容をレポートだけで返します
+	DryRun bool
+}
+
+// FavoriteRestoreItem は復元されなかったお気に入り1件です
+type FavoriteRestoreItem struct {
+	Type       FavoriteType `json:"type"`
+	Group      string       `json:"group"`
+	FavoriteID string       `json:"favoriteId"`
+	Reason     string       `json:"reason"`
+}
+
+// FavoritesRestoreReport はお気に入り復元の結果です
+type FavoritesRestoreReport struct {
+	// Restored は追加された（DryRun の場合は追加される）お気に入りの数です
+	Restored int `json:"restored"`
+	// Skipped は既に登録済み・削除済み・非公開のため追加しなかったお気に入りです
+	Skipped []FavoriteRestoreItem `json:"skipped,omitempty"`
+	// Failed は上限超過やエラーのため追加できなかったお気に入りです
+	Failed []FavoriteRestoreItem `json:"failed,omitempty"`
+}
@@ -0,0 +1,96 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GroupAuditEvent は種別ごとに型付けされたグループ監査ログのイベントです
+type GroupAuditEvent interface {
+	// AuditEntry は元の監査ログエントリを返します
+	AuditEntry() *GroupAuditLogEntry
+}
+
+// GroupAuditMemberEvent はメンバーの参加・脱退・キック・BAN・BAN解除のイベントです。
+// ActorID が操作したユーザー、TargetID が対象のユーザーです。
+type GroupAuditMemberEvent struct {
+	Entry GroupAuditLogEntry
+}
+
+// GroupAuditRoleAssignEvent はメンバーへのロール付与・解除のイベントです
+type GroupAuditRoleAssignEvent struct {
+	Entry    GroupAuditLogEntry `json:"-"`
+	RoleID   string             `json:"roleId"`
+	RoleName string             `json:"roleName"`
+}
+
+// GroupAuditChange は監査ログに記録された値の変更前後です
+type GroupAuditChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// GroupAuditUpdateEvent はグループ・ロール・メンバー情報の変更イベントです。
+// Changes は変更されたフィールド名ごとの変更前後の値です。
+type GroupAuditUpdateEvent struct {
+	Entry   GroupAuditLogEntry
+	Changes map[string]GroupAuditChange
+}
+
+// GroupAuditGenericEvent は専用の型を持たないイベントです
+type GroupAuditGenericEvent struct {
+	Entry GroupAuditLogEntry
+}
+
+// AuditEntry は元の監査ログエントリを返します
+func (e *GroupAuditMemberEvent) AuditEntry() *GroupAuditLogEntry { return &e.Entry }
+
+// AuditEntry は元の監査ログエントリを返します
+func (e *GroupAuditRoleAssignEvent) AuditEntry() *GroupAuditLogEntry { return &e.Entry }
+
+// AuditEntry は元の監査ログエントリを返します
+func (e *GroupAuditUpdateEvent) AuditEntry() *GroupAuditLogEntry { return &e.Entry }
+
+// AuditEntry は元の監査ログエントリを返します
+func (e *GroupAuditGenericEvent) AuditEntry() *GroupAuditLogEntry { return &e.Entry }
+
+// Event はエントリをイベント種別に応じた型にデコードします
+func (e GroupAuditLogEntry) Event() (GroupAuditEvent, error) {
+	switch e.EventType {
+	case GroupAuditEventMemberJoin, GroupAuditEventMemberLeave, GroupAuditEventMemberRemove,
+		GroupAuditEventUserBan, GroupAuditEventUserUnban:
+		return &GroupAuditMemberEvent{Entry: e}, nil
+
+	case GroupAuditEventMemberRoleAssign, GroupAuditEventMemberRoleUnassign:
+		event := &GroupAuditRoleAssignEvent{Entry: e}
+		if err := e.DecodeData(event); err != nil {
+			return nil, err
+		}
+		return event, nil
+
+	case GroupAuditEventUpdate, GroupAuditEventRoleUpdate, GroupAuditEventMemberUpdate:
+		event := &GroupAuditUpdateEvent{Entry: e}
+		if err := e.DecodeData(&event.Changes); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+	return &GroupAuditGenericEvent{Entry: e}, nil
+}
+
+// DecodeData はエントリの data を v にデコードします。data が空の場合は何もしません。
+func (e GroupAuditLogEntry) DecodeData(v interface{}) error {
+	if len(e.Data) == 0 || string(e.Data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return fmt.Errorf("failed to decode %s audit data: %w", e.EventType, err)
+	}
+	return nil
+}
+
+// CreatedTime はエントリの作成日時を解析して返します
+func (e GroupAuditLogEntry) CreatedTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.CreatedAt)
+}
@@ -0,0 +1,88 @@
+package shared
+
+import "sort"
+
+// GroupPermission はグループロールに付与できる権限です
+type GroupPermission string
+
+const (
+	GroupPermissionAll                        GroupPermission = "*"
+	GroupPermissionAnnouncementManage         GroupPermission = "group-announcement-manage"
+	GroupPermissionAuditView                  GroupPermission = "group-audit-view"
+	GroupPermissionBansManage                 GroupPermission = "group-bans-manage"
+	GroupPermissionCalendarManage             GroupPermission = "group-calendar-manage"
+	GroupPermissionDataManage                 GroupPermission = "group-data-manage"
+	GroupPermissionDefaultRoleManage          GroupPermission = "group-default-role-manage"
+	GroupPermissionGalleriesManage            GroupPermission = "group-galleries-manage"
+	GroupPermissionInstanceAgeGatedCreate     GroupPermission = "group-instance-age-gated-create"
+	GroupPermissionInstanceJoin               GroupPermission = "group-instance-join"
+	GroupPermissionInstanceManage             GroupPermission = "group-instance-manage"
+	GroupPermissionInstanceModerate           GroupPermission = "group-instance-moderate"
+	GroupPermissionInstanceOpenCreate         GroupPermission = "group-instance-open-create"
+	GroupPermissionInstancePlusCreate         GroupPermission = "group-instance-plus-create"
+	GroupPermissionInstancePlusPortal         GroupPermission = "group-instance-plus-portal"
+	GroupPermissionInstancePlusPortalUnlocked GroupPermission = "group-instance-plus-portal-unlocked"
+	GroupPermissionInstancePublicCreate       GroupPermission = "group-instance-public-create"
+	GroupPermissionInstanceQueuePriority      GroupPermission = "group-instance-queue-priority"
+	GroupPermissionInstanceRestrictedCreate   GroupPermission = "group-instance-restricted-create"
+	GroupPermissionInvitesManage              GroupPermission = "group-invites-manage"
+	GroupPermissionMembersManage              GroupPermission = "group-members-manage"
+	GroupPermissionMembersRemove              GroupPermission = "group-members-remove"
+	GroupPermissionMembersViewAll             GroupPermission = "group-members-viewall"
+	GroupPermissionRolesAssign                GroupPermission = "group-roles-assign"
+	GroupPermissionRolesManage                GroupPermission = "group-roles-manage"
+)
+
+// GroupPermissionSet はグループ権限の集合です
+type GroupPermissionSet map[GroupPermission]struct{}
+
+// NewGroupPermissionSet は指定された権限からなる集合を作成します
+func NewGroupPermissionSet(permissions ...GroupPermission) GroupPermissionSet {
+	set := make(GroupPermissionSet, len(permissions))
+	for _, p := range permissions {
+		set[p] = struct{}{}
+	}
+	return set
+}
+
+// Has は権限を持っているかを判定します。"*" を持つ場合はすべての権限を持つものとして扱います。
+func (s GroupPermissionSet) Has(permission GroupPermission) bool {
+	if _, ok := s[GroupPermissionAll]; ok {
+		return true
+	}
+	_, ok := s[permission]
+	return ok
+}
+
+// List は権限を名前順に並べて返します
+func (s GroupPermissionSet) List() []GroupPermission {
+	list := make([]GroupPermission, 0, len(s))
+	for p := range s {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// EffectiveGroupPermissions はメンバーが持つロールの権限を合成した集合を返します。
+// roles にはグループのすべてのロールを渡し、メンバーの RoleIDs と MRoleIDs（管理ロール）に含まれるものだけが対象になります。
+func EffectiveGroupPermissions(member GroupMember, roles []GroupRole) GroupPermissionSet {
+	assigned := make(map[string]struct{}, len(member.RoleIDs)+len(member.MRoleIDs))
+	for _, id := range member.RoleIDs {
+		assigned[id] = struct{}{}
+	}
+	for _, id := range member.MRoleIDs {
+		assigned[id] = struct{}{}
+	}
+
+	set := GroupPermissionSet{}
+	for _, role := range roles {
+		if _, ok := assigned[role.ID]; !ok {
+			continue
+		}
+		for _, p := range role.Permissions {
+			set[p] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEffectiveGroupPermissions(t *testing.T) {
+	roles := []GroupRole{
+		{ID: "grol_member", Permissions: []GroupPermission{GroupPermissionInstanceJoin}},
+		{ID: "grol_moderator", Permissions: []GroupPermission{GroupPermissionBansManage, GroupPermissionMembersRemove}},
+		{ID: "grol_admin", Permissions: []GroupPermission{GroupPermissionRolesManage, GroupPermissionInstanceJoin}},
+		{ID: "grol_owner", Permissions: []GroupPermission{GroupPermissionAll}},
+	}
+
+	tests := []struct {
+		name   string
+		member GroupMember
+		want   []GroupPermission
+	}{
+		{
+			name:   "no roles",
+			member: GroupMember{},
+			want:   []GroupPermission{},
+		},
+		{
+			name:   "regular roles only",
+			member: GroupMember{RoleIDs: []string{"grol_member"}},
+			want:   []GroupPermission{GroupPermissionInstanceJoin},
+		},
+		{
+			name:   "management roles only",
+			member: GroupMember{MRoleIDs: []string{"grol_moderator"}},
+			want:   []GroupPermission{GroupPermissionBansManage, GroupPermissionMembersRemove},
+		},
+		{
+			name:   "regular and management roles are merged",
+			member: GroupMember{RoleIDs: []string{"grol_member"}, MRoleIDs: []string{"grol_admin", "grol_moderator"}},
+			want: []GroupPermission{
+				GroupPermissionBansManage,
+				GroupPermissionInstanceJoin,
+				GroupPermissionMembersRemove,
+				GroupPermissionRolesManage,
+			},
+		},
+		{
+			name:   "unknown role is ignored",
+			member: GroupMember{RoleIDs: []string{"grol_deleted"}},
+			want:   []GroupPermission{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EffectiveGroupPermissions(tt.member, roles).List()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("EffectiveGroupPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupPermissionSetHas(t *testing.T) {
+	set := EffectiveGroupPermissions(GroupMember{MRoleIDs: []string{"grol_owner"}}, []GroupRole{
+		{ID: "grol_owner", Permissions: []GroupPermission{GroupPermissionAll}},
+	})
+	if !set.Has(GroupPermissionBansManage) {
+		t.Error(`Has() = false for a member with "*", want true`)
+	}
+
+	set = NewGroupPermissionSet(GroupPermissionInstanceJoin)
+	if !set.Has(GroupPermissionInstanceJoin) {
+		t.Error("Has() = false for a granted permission, want true")
+	}
+	if set.Has(GroupPermissionBansManage) {
+		t.Error("Has() = true for a missing permission, want false")
+	}
+}
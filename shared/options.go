@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
@@ -13,6 +14,35 @@ type ClientConfig struct {
 	Proxy      *url.URL
 	HTTPClient *http.Client
 	BaseURL    string
+
+	// RawResponseHandler は各レスポンスの生のボディとデコード結果を受け取ります
+	RawResponseHandler func(resp *RawResponse)
+
+	// SchemaDriftHandler と SchemaDriftLogger のいずれかを設定するとスキーマ差分の検出が有効になります
+	SchemaDriftHandler func(drift SchemaDrift)
+	SchemaDriftLogger  *slog.Logger
+
+	// Cache を設定すると読み取り系エンドポイントのレスポンスがキャッシュされます
+	Cache     Cache
+	CacheTTLs map[string]time.Duration
+
+	// DisableRequestCoalescing は同時に発行された同一のGETリクエストの集約を無効にします
+	DisableRequestCoalescing bool
+
+	// RateLimit は1秒あたりのリクエスト数の上限です（0 の場合は制限しません）
+	RateLimit      float64
+	RateLimitBurst int
+}
+
+// RawResponse はデコード前のAPIレスポンスです
+type RawResponse struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Result はボディのデコード先です（エラーレスポンスや結果を受け取らない呼び出しでは nil）
+	Result interface{}
 }
 
 // Option はクライアント設定オプションです
@@ -56,3 +86,56 @@ func WithBaseURL(baseURL string) Option {
 		c.BaseURL = baseURL
 	}
 }
+
+// WithRawResponseHandler はレスポンスの生のボディを受け取るハンドラーを設定します
+func WithRawResponseHandler(handler func(resp *RawResponse)) Option {
+	return func(c *ClientConfig) {
+		c.RawResponseHandler = handler
+	}
+}
+
+// WithSchemaDriftHandler はスキーマ差分を受け取るハンドラーを設定します
+func WithSchemaDriftHandler(handler func(drift SchemaDrift)) Option {
+	return func(c *ClientConfig) {
+		c.SchemaDriftHandler = handler
+	}
+}
+
+// WithSchemaDriftLogger はスキーマ差分を出力するロガーを設定します
+func WithSchemaDriftLogger(logger *slog.Logger) Option {
+	return func(c *ClientConfig) {
+		c.SchemaDriftLogger = logger
+	}
+}
+
+// WithCache はレスポンスキャッシュを設定します
+func WithCache(cache Cache) Option {
+	return func(c *ClientConfig) {
+		c.Cache = cache
+	}
+}
+
+// WithCacheTTL はリソースごとのキャッシュ有効期間を設定します
+func WithCacheTTL(resource string, ttl time.Duration) Option {
+	return func(c *ClientConfig) {
+		if c.CacheTTLs == nil {
+			c.CacheTTLs = make(map[string]time.Duration)
+		}
+		c.CacheTTLs[resource] = ttl
+	}
+}
+
+// WithRequestCoalescing は同時に発行された同一のGETリクエストの集約を有効または無効にします
+func WithRequestCoalescing(enabled bool) Option {
+	return func(c *ClientConfig) {
+		c.DisableRequestCoalescing = !enabled
+	}
+}
+
+// WithRateLimit は1秒あたりのリクエスト数の上限とバースト数を設定します
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *ClientConfig) {
+		c.RateLimit = requestsPerSecond
+		c.RateLimitBurst = burst
+	}
+}
@@ -1,6 +1,11 @@
 package shared
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
 
 // NotificationDetails は VRChat API が details フィールドをオブジェクトまたは
 // 文字列で返す場合があるため、両方を受け入れるカスタム型。
@@ -71,8 +76,6 @@ type CurrentUser struct {
 	AccountDeletionLog      *string  `json:"accountDeletionLog,omitempty"`
 	AcceptedTOSVersion      int      `json:"acceptedTOSVersion"`
 	AcceptedPrivacyVersion  int      `json:"acceptedPrivacyVersion"`
-	SteamDetails            struct{} `json:"steamDetails"`
-	OculusDetails           struct{} `json:"oculusDetails"`
 	HasLoggedInFromClient   bool     `json:"hasLoggedInFromClient"`
 	FriendKey               string   `json:"friendKey"`
 	OnlineFriends           []string `json:"onlineFriends"`
@@ -93,6 +96,13 @@ type CurrentUser struct {
 	} `json:"pastDisplayNames"`
 	TwoFactorAuthEnabled      bool    `json:"twoFactorAuthEnabled"`
 	TwoFactorAuthEnabledDate  *string `json:"twoFactorAuthEnabledDate,omitempty"`
+
+	// ストア連携の詳細は形式が一定でないため生のJSONで保持します
+	SteamDetails  json.RawMessage `json:"steamDetails"`
+	OculusDetails json.RawMessage `json:"oculusDetails"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // User はVRChatユーザーの情報です
@@ -125,6 +135,9 @@ type User struct {
 		DisplayName string `json:"displayName"`
 		UpdatedAt   string `json:"updated_at"`
 	} `json:"pastDisplayNames"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // LimitedUser は制限されたユーザー情報です（検索結果など）
@@ -144,6 +157,9 @@ type LimitedUser struct {
 	LastPlatform           string   `json:"last_platform"`
 	Location               string   `json:"location"`
 	DeveloperType          string   `json:"developerType"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // UpdateUserRequest はユーザー情報更新リクエストです
@@ -157,6 +173,111 @@ type UpdateUserRequest struct {
 	Bio                *string  `json:"bio,omitempty"`
 	BioLinks           []string `json:"bioLinks,omitempty"`
 	UserIcon           *string  `json:"userIcon,omitempty"`
+	Pronouns           *string  `json:"pronouns,omitempty"`
+	IsBoopingEnabled   *bool    `json:"isBoopingEnabled,omitempty"`
+}
+
+// UserStatus はユーザーのステータスです
+type UserStatus string
+
+const (
+	UserStatusActive  UserStatus = "active"
+	UserStatusJoinMe  UserStatus = "join me"
+	UserStatusAskMe   UserStatus = "ask me"
+	UserStatusBusy    UserStatus = "busy"
+	UserStatusOffline UserStatus = "offline"
+)
+
+// StatusPreset はステータスとステータスメッセージの組み合わせです
+type StatusPreset struct {
+	Status      UserStatus `json:"status"`
+	Description string     `json:"description"`
+}
+
+// languageTagPrefix は言語タグの接頭辞です
+const languageTagPrefix = "language_"
+
+// ApplyStatusPreset はステータスとステータスメッセージをプリセットの内容に設定します
+func (r *UpdateUserRequest) ApplyStatusPreset(preset StatusPreset) {
+	status := string(preset.Status)
+	r.Status = &status
+	r.StatusDescription = &preset.Description
+}
+
+// SetLanguages はプロフィールの言語を設定します。
+// タグは丸ごと置き換えられるため、現在のタグ（CurrentUser.Tags）を渡すと
+// 言語以外のタグを維持したまま言語タグ（"language_eng" など）だけを差し替えます。
+// codes には "eng", "jpn" のような言語コードを指定します。
+func (r *UpdateUserRequest) SetLanguages(currentTags []string, codes ...string) {
+	tags := make([]string, 0, len(currentTags)+len(codes))
+	for _, tag := range currentTags {
+		if !strings.HasPrefix(tag, languageTagPrefix) {
+			tags = append(tags, tag)
+		}
+	}
+	for _, code := range codes {
+		tags = append(tags, languageTagPrefix+code)
+	}
+	r.Tags = tags
+}
+
+// UserNote はユーザーに付けたメモです
+type UserNote struct {
+	ID           string       `json:"id"`
+	UserID       string       `json:"userId"`
+	TargetUserID string       `json:"targetUserId"`
+	Note         string       `json:"note"`
+	TargetUser   *LimitedUser `json:"targetUser,omitempty"`
+	CreatedAt    string       `json:"createdAt"`
+}
+
+// UpdateUserNoteRequest はユーザーメモ更新リクエストです
+type UpdateUserNoteRequest struct {
+	TargetUserID string `json:"targetUserId"`
+	Note         string `json:"note"`
+}
+
+// ReportCategory はユーザー報告の対象です
+type ReportCategory string
+
+const (
+	ReportCategoryProfile  ReportCategory = "profile"
+	ReportCategoryBehavior ReportCategory = "behavior"
+)
+
+// ReportReason はユーザー報告の理由です
+type ReportReason string
+
+const (
+	ReportReasonHarassment    ReportReason = "harassment"
+	ReportReasonHateful       ReportReason = "hateful"
+	ReportReasonSexual        ReportReason = "sexual"
+	ReportReasonViolence      ReportReason = "violence"
+	ReportReasonImpersonation ReportReason = "impersonation"
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonOther         ReportReason = "other"
+)
+
+// ReportUserRequest はユーザー報告リクエストです。
+// Type と ContentID は ReportUser が設定します。
+type ReportUserRequest struct {
+	Type        string         `json:"type"`
+	ContentID   string         `json:"contentId"`
+	Category    ReportCategory `json:"category"`
+	Reason      ReportReason   `json:"reason"`
+	Description string         `json:"description,omitempty"`
+}
+
+// ModerationReport は送信したモデレーション報告です
+type ModerationReport struct {
+	ID          string         `json:"id"`
+	Type        string         `json:"type"`
+	ContentID   string         `json:"contentId"`
+	Category    ReportCategory `json:"category"`
+	Reason      ReportReason   `json:"reason"`
+	Description string         `json:"description"`
+	Status      string         `json:"status"`
+	CreatedAt   string         `json:"created_at"`
 }
 
 // UserGroup はユーザーのグループ情報です
@@ -204,6 +325,9 @@ type UserGroup struct {
 		Visibility                      string   `json:"visibility"`
 		IsSubscribedToAnnouncements     bool     `json:"isSubscribedToAnnouncements"`
 	} `json:"myMember"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Avatar はアバター情報です
@@ -224,8 +348,13 @@ type Avatar struct {
 	UnityPackages         []UnityPackage `json:"unityPackages"`
 	UnityPackageURL       string         `json:"unityPackageUrl"`
 	UnityPackageURLObject string         `json:"unityPackageUrlObject"`
-	CreatedAt             string         `json:"created_at"`
-	UpdatedAt             string         `json:"updated_at"`
+	// Performance はプラットフォームごとのパフォーマンスランクです
+	Performance map[string]PerformanceRating `json:"performance,omitempty"`
+	CreatedAt   string                       `json:"created_at"`
+	UpdatedAt   string                       `json:"updated_at"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // UnityPackage はUnityパッケージ情報です
@@ -240,6 +369,62 @@ type UnityPackage struct {
 	PluginURLObject  string `json:"pluginUrlObject"`
 	UnitySortNumber  int64  `json:"unitySortNumber"`
 	UnityVersion     string `json:"unityVersion"`
+
+	// Variant はパッケージの種類です（"standard", "impostor" など）
+	Variant             string            `json:"variant,omitempty"`
+	PerformanceRating   PerformanceRating `json:"performanceRating,omitempty"`
+	ScanStatus          string            `json:"scanStatus,omitempty"`
+	ImpostorizerVersion string            `json:"impostorizerVersion,omitempty"`
+	ImpostorURL         string            `json:"impostorUrl,omitempty"`
+}
+
+// PerformanceRating はアバターのパフォーマンスランクです
+type PerformanceRating string
+
+const (
+	PerformanceRatingNone      PerformanceRating = "None"
+	PerformanceRatingExcellent PerformanceRating = "Excellent"
+	PerformanceRatingGood      PerformanceRating = "Good"
+	PerformanceRatingMedium    PerformanceRating = "Medium"
+	PerformanceRatingPoor      PerformanceRating = "Poor"
+	PerformanceRatingVeryPoor  PerformanceRating = "VeryPoor"
+)
+
+// Unityパッケージの種類
+const (
+	UnityPackageVariantStandard = "standard"
+	UnityPackageVariantImpostor = "impostor"
+)
+
+// UnityPackageFor は指定されたプラットフォームの通常のUnityパッケージを返します（存在しない場合は nil）
+func (a Avatar) UnityPackageFor(platform string) *UnityPackage {
+	for i, pkg := range a.UnityPackages {
+		if pkg.Platform == platform && (pkg.Variant == "" || pkg.Variant == UnityPackageVariantStandard) {
+			return &a.UnityPackages[i]
+		}
+	}
+	return nil
+}
+
+// HasImpostor は指定されたプラットフォームでインポスターが利用可能かを判定します
+func (a Avatar) HasImpostor(platform string) bool {
+	for _, pkg := range a.UnityPackages {
+		if pkg.Platform == platform && (pkg.Variant == UnityPackageVariantImpostor || pkg.ImpostorURL != "") {
+			return true
+		}
+	}
+	return false
+}
+
+// PerformanceFor は指定されたプラットフォームのパフォーマンスランクを返します
+func (a Avatar) PerformanceFor(platform string) PerformanceRating {
+	if rating, ok := a.Performance[platform]; ok {
+		return rating
+	}
+	if pkg := a.UnityPackageFor(platform); pkg != nil {
+		return pkg.PerformanceRating
+	}
+	return ""
 }
 
 // World はワールド情報です
@@ -273,6 +458,9 @@ type World struct {
 	PreviewYoutubeID     *string        `json:"previewYoutubeId"`
 	UdonProducts         []string       `json:"udonProducts"`
 	Heat                 int            `json:"heat"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // LimitedWorld は制限されたワールド情報です
@@ -299,6 +487,9 @@ type LimitedWorld struct {
 	PrivateOccupants    int            `json:"privateOccupants"`
 	Occupants           int            `json:"occupants"`
 	UnityPackages       []UnityPackage `json:"unityPackages"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Instance はインスタンス情報です
@@ -329,6 +520,9 @@ type Instance struct {
 		Android           int `json:"android"`
 		StandaloneWindows int `json:"standalonewindows"`
 	} `json:"platforms"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // FriendStatus はフレンドステータスです
@@ -338,6 +532,27 @@ type FriendStatus struct {
 	OutgoingRequest bool `json:"outgoingRequest"`
 }
 
+// FriendRequestDirection はフレンドリクエストの方向です
+type FriendRequestDirection string
+
+const (
+	FriendRequestIncoming FriendRequestDirection = "incoming"
+	FriendRequestOutgoing FriendRequestDirection = "outgoing"
+)
+
+// FriendRequest は保留中のフレンドリクエストです
+type FriendRequest struct {
+	// NotificationID はリクエストの通知IDです（受信したリクエストの承認・拒否に使用します）
+	NotificationID string
+	// UserID は相手のユーザーIDです
+	UserID string
+	// DisplayName は相手の表示名です。送信したリクエストの通知には受信者のIDしか含まれないため、
+	// Direction が FriendRequestOutgoing の場合は常に空です（必要な場合は GetUser で取得します）。
+	DisplayName string
+	Direction   FriendRequestDirection
+	CreatedAt   string
+}
+
 // Notification は通知情報です
 type Notification struct {
 	ID             string                 `json:"id"`
@@ -349,6 +564,9 @@ type Notification struct {
 	Details        NotificationDetails    `json:"details"`
 	Seen           bool                   `json:"seen"`
 	CreatedAt      string                 `json:"created_at"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TwoFactorAuthRequest は2FA検証リクエストです
@@ -363,21 +581,76 @@ type TwoFactorAuthResponse struct {
 
 // Favorite はお気に入り情報です
 type Favorite struct {
-	ID         string   `json:"id"`
-	Type       string   `json:"type"` // "world", "avatar", "friend"
-	FavoriteID string   `json:"favoriteId"`
-	Tags       []string `json:"tags"`
+	ID         string       `json:"id"`
+	Type       FavoriteType `json:"type"`
+	FavoriteID string       `json:"favoriteId"`
+	Tags       []string     `json:"tags"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
+// FavoriteType はお気に入りの種類です
+type FavoriteType string
+
+const (
+	FavoriteTypeWorld  FavoriteType = "world"
+	FavoriteTypeAvatar FavoriteType = "avatar"
+	FavoriteTypeFriend FavoriteType = "friend"
+)
+
+// FavoriteGroupVisibility はお気に入りグループの公開範囲です
+type FavoriteGroupVisibility string
+
+const (
+	FavoriteGroupVisibilityPrivate FavoriteGroupVisibility = "private"
+	FavoriteGroupVisibilityFriends FavoriteGroupVisibility = "friends"
+	FavoriteGroupVisibilityPublic  FavoriteGroupVisibility = "public"
+)
+
 // FavoriteGroup はお気に入りグループです
 type FavoriteGroup struct {
-	ID         string   `json:"id"`
-	Type       string   `json:"type"`
-	OwnerID    string   `json:"ownerId"`
-	Name       string   `json:"name"`
-	DisplayName string   `json:"displayName"`
-	Visibility string   `json:"visibility"`
-	Tags       []string `json:"tags"`
+	ID          string                  `json:"id"`
+	Type        FavoriteType            `json:"type"`
+	OwnerID     string                  `json:"ownerId"`
+	Name        string                  `json:"name"`
+	DisplayName string                  `json:"displayName"`
+	Visibility  FavoriteGroupVisibility `json:"visibility"`
+	Tags        []string                `json:"tags"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UpdateFavoriteGroupRequest はお気に入りグループ更新リクエストです
+type UpdateFavoriteGroupRequest struct {
+	DisplayName string                  `json:"displayName,omitempty"`
+	Visibility  FavoriteGroupVisibility `json:"visibility,omitempty"`
+	Tags        []string                `json:"tags,omitempty"`
+}
+
+// FavoriteLimits はお気に入りの上限です
+type FavoriteLimits struct {
+	DefaultMaxFavoriteGroups    int                  `json:"defaultMaxFavoriteGroups"`
+	DefaultMaxFavoritesPerGroup int                  `json:"defaultMaxFavoritesPerGroup"`
+	MaxFavoriteGroups           map[FavoriteType]int `json:"maxFavoriteGroups"`
+	MaxFavoritesPerGroup        map[FavoriteType]int `json:"maxFavoritesPerGroup"`
+}
+
+// GroupsFor は指定された種類のお気に入りグループの上限数を返します
+func (l FavoriteLimits) GroupsFor(favoriteType FavoriteType) int {
+	if n, ok := l.MaxFavoriteGroups[favoriteType]; ok {
+		return n
+	}
+	return l.DefaultMaxFavoriteGroups
+}
+
+// PerGroupFor は指定された種類のお気に入りグループ1つあたりの上限数を返します
+func (l FavoriteLimits) PerGroupFor(favoriteType FavoriteType) int {
+	if n, ok := l.MaxFavoritesPerGroup[favoriteType]; ok {
+		return n
+	}
+	return l.DefaultMaxFavoritesPerGroup
 }
 
 // Group はグループ情報です
@@ -386,7 +659,9 @@ type Group struct {
 	Name             string   `json:"name"`
 	ShortCode        string   `json:"shortCode"`
 	Description      string   `json:"description"`
+	IconID           string   `json:"iconId"`
 	IconURL          string   `json:"iconUrl"`
+	BannerID         string   `json:"bannerId"`
 	BannerURL        string   `json:"bannerUrl"`
 	Privacy          string   `json:"privacy"`
 	OwnerID          string   `json:"ownerId"`
@@ -399,24 +674,127 @@ type Group struct {
 	IsVerified       bool     `json:"isVerified"`
 	JoinState        string   `json:"joinState"`
 	MembershipStatus string   `json:"membershipStatus"`
+	Galleries        []GroupGallery `json:"galleries,omitempty"`
 	CreatedAt        string   `json:"createdAt"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GroupJoinState はグループへの参加方法です
+type GroupJoinState string
+
+const (
+	GroupJoinStateOpen    GroupJoinState = "open"
+	GroupJoinStateRequest GroupJoinState = "request"
+	GroupJoinStateInvite  GroupJoinState = "invite"
+	GroupJoinStateClosed  GroupJoinState = "closed"
+)
+
+// GroupPrivacy はグループの公開設定です
+type GroupPrivacy string
+
+const (
+	GroupPrivacyDefault GroupPrivacy = "default"
+	GroupPrivacyPrivate GroupPrivacy = "private"
+)
+
+// CreateGroupRequest はグループ作成リクエストです
+type CreateGroupRequest struct {
+	Name        string         `json:"name"`
+	ShortCode   string         `json:"shortCode"`
+	Description string         `json:"description,omitempty"`
+	JoinState   GroupJoinState `json:"joinState,omitempty"`
+	IconID      string         `json:"iconId,omitempty"`
+	BannerID    string         `json:"bannerId,omitempty"`
+	Privacy     GroupPrivacy   `json:"privacy,omitempty"`
+	// RoleTemplate は初期ロールのテンプレートです（"default", "managedFree", "managedInvite", "managedRequest"）
+	RoleTemplate string `json:"roleTemplate,omitempty"`
+}
+
+// UpdateGroupRequest はグループ更新リクエストです。
+// nil のフィールドは変更されません。空のスライスを指すポインタを渡すとリストを空にできます。
+type UpdateGroupRequest struct {
+	Name        *string        `json:"name,omitempty"`
+	ShortCode   *string        `json:"shortCode,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Rules       *string        `json:"rules,omitempty"`
+	Links       *[]string      `json:"links,omitempty"`
+	Languages   *[]string      `json:"languages,omitempty"`
+	Tags        *[]string      `json:"tags,omitempty"`
+	JoinState   GroupJoinState `json:"joinState,omitempty"`
+	Privacy     GroupPrivacy   `json:"privacy,omitempty"`
+	IconID      *string        `json:"iconId,omitempty"`
+	BannerID    *string        `json:"bannerId,omitempty"`
+}
+
+// GroupInstance はグループで開かれているインスタンスです
+type GroupInstance struct {
+	InstanceID  string        `json:"instanceId"`
+	Location    string        `json:"location"`
+	World       *LimitedWorld `json:"world,omitempty"`
+	MemberCount int           `json:"memberCount"`
 }
 
 // GroupMember はグループメンバー情報です
 type GroupMember struct {
-	ID                 string      `json:"id"`
-	GroupID            string      `json:"groupId"`
-	UserID             string      `json:"userId"`
-	RoleIDs            []string    `json:"roleIds"`
-	User               *LimitedUser `json:"user,omitempty"`
-	IsRepresenting     bool        `json:"isRepresenting"`
-	MembershipStatus   string      `json:"membershipStatus"`
-	Visibility         string      `json:"visibility"`
-	JoinedAt           string      `json:"joinedAt"`
-	CreatedAt          string      `json:"createdAt"`
-	BannedAt           *string     `json:"bannedAt,omitempty"`
+	ID                          string                `json:"id"`
+	GroupID                     string                `json:"groupId"`
+	UserID                      string                `json:"userId"`
+	RoleIDs                     []string              `json:"roleIds"`
+	MRoleIDs                    []string              `json:"mRoleIds,omitempty"`
+	User                        *LimitedUser          `json:"user,omitempty"`
+	IsRepresenting              bool                  `json:"isRepresenting"`
+	IsSubscribedToAnnouncements bool                  `json:"isSubscribedToAnnouncements"`
+	MembershipStatus            GroupMemberStatus     `json:"membershipStatus"`
+	Visibility                  GroupMemberVisibility `json:"visibility"`
+	ManagerNotes                string                `json:"managerNotes,omitempty"`
+	HasJoinedFromPurchase       bool                  `json:"hasJoinedFromPurchase,omitempty"`
+	JoinedAt                    string                `json:"joinedAt"`
+	CreatedAt                   string                `json:"createdAt"`
+	BannedAt                    *string               `json:"bannedAt,omitempty"`
+	LastPostReadAt              *string               `json:"lastPostReadAt,omitempty"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GroupMemberStatus はグループメンバーの所属状態です
+type GroupMemberStatus string
+
+const (
+	GroupMemberStatusInactive    GroupMemberStatus = "inactive"
+	GroupMemberStatusMember      GroupMemberStatus = "member"
+	GroupMemberStatusRequested   GroupMemberStatus = "requested"
+	GroupMemberStatusInvited     GroupMemberStatus = "invited"
+	GroupMemberStatusBanned      GroupMemberStatus = "banned"
+	GroupMemberStatusUserBlocked GroupMemberStatus = "userblocked"
+)
+
+// GroupMemberVisibility はグループメンバーシップの公開範囲です
+type GroupMemberVisibility string
+
+const (
+	GroupMemberVisibilityVisible GroupMemberVisibility = "visible"
+	GroupMemberVisibilityFriends GroupMemberVisibility = "friends"
+	GroupMemberVisibilityHidden  GroupMemberVisibility = "hidden"
+)
+
+// UpdateGroupMemberRequest はグループメンバー更新リクエストです
+type UpdateGroupMemberRequest struct {
+	Visibility                  GroupMemberVisibility `json:"visibility,omitempty"`
+	IsSubscribedToAnnouncements *bool                 `json:"isSubscribedToAnnouncements,omitempty"`
+	ManagerNotes                *string               `json:"managerNotes,omitempty"`
 }
 
+// GroupJoinRequestAction はグループ参加リクエストへの応答です
+type GroupJoinRequestAction string
+
+const (
+	GroupJoinRequestAccept GroupJoinRequestAction = "accept"
+	GroupJoinRequestReject GroupJoinRequestAction = "reject"
+)
+
 // GroupAnnouncement はグループのお知らせです
 type GroupAnnouncement struct {
 	ID        string `json:"id"`
@@ -428,6 +806,95 @@ type GroupAnnouncement struct {
 	ImageURL  string `json:"imageUrl"`
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CreateGroupAnnouncementRequest はグループのお知らせ作成リクエストです
+type CreateGroupAnnouncementRequest struct {
+	Title            string `json:"title"`
+	Text             string `json:"text,omitempty"`
+	ImageID          string `json:"imageId,omitempty"`
+	SendNotification bool   `json:"sendNotification,omitempty"`
+}
+
+// GroupPostVisibility はグループ投稿の公開範囲です
+type GroupPostVisibility string
+
+const (
+	GroupPostVisibilityGroup  GroupPostVisibility = "group"
+	GroupPostVisibilityPublic GroupPostVisibility = "public"
+)
+
+// GroupPost はグループの投稿です
+type GroupPost struct {
+	ID         string              `json:"id"`
+	GroupID    string              `json:"groupId"`
+	AuthorID   string              `json:"authorId"`
+	EditorID   string              `json:"editorId,omitempty"`
+	Visibility GroupPostVisibility `json:"visibility"`
+	RoleIDs    []string            `json:"roleIds"`
+	Title      string              `json:"title"`
+	Text       string              `json:"text"`
+	ImageID    string              `json:"imageId"`
+	ImageURL   string              `json:"imageUrl"`
+	CreatedAt  string              `json:"createdAt"`
+	UpdatedAt  string              `json:"updatedAt"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GroupPostRequest はグループ投稿の作成リクエストです。
+// ImageID には UploadGalleryImage などでアップロードした画像のファイルIDを指定します。
+type GroupPostRequest struct {
+	Title            string              `json:"title"`
+	Text             string              `json:"text"`
+	ImageID          string              `json:"imageId,omitempty"`
+	SendNotification bool                `json:"sendNotification"`
+	Visibility       GroupPostVisibility `json:"visibility"`
+	// RoleIDs を指定すると、そのロールを持つメンバーだけが投稿を閲覧できます
+	RoleIDs []string `json:"roleIds,omitempty"`
+}
+
+// UpdateGroupPostRequest はグループ投稿の更新リクエストです。
+// nil のフィールドは変更されません。
+type UpdateGroupPostRequest struct {
+	Title            *string             `json:"title,omitempty"`
+	Text             *string             `json:"text,omitempty"`
+	ImageID          *string             `json:"imageId,omitempty"`
+	SendNotification *bool               `json:"sendNotification,omitempty"`
+	Visibility       GroupPostVisibility `json:"visibility,omitempty"`
+	RoleIDs          *[]string           `json:"roleIds,omitempty"`
+}
+
+// GroupGallery はグループのギャラリーです
+type GroupGallery struct {
+	ID                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	Description          string   `json:"description"`
+	MembersOnly          bool     `json:"membersOnly"`
+	RoleIDsToView        []string `json:"roleIdsToView"`
+	RoleIDsToSubmit      []string `json:"roleIdsToSubmit"`
+	RoleIDsToAutoApprove []string `json:"roleIdsToAutoApprove"`
+	RoleIDsToManage      []string `json:"roleIdsToManage"`
+	CreatedAt            string   `json:"createdAt"`
+	UpdatedAt            string   `json:"updatedAt"`
+}
+
+// GroupGalleryImage はグループギャラリーの画像です
+type GroupGalleryImage struct {
+	ID                string  `json:"id"`
+	GroupID           string  `json:"groupId"`
+	GalleryID         string  `json:"galleryId"`
+	FileID            string  `json:"fileId"`
+	ImageURL          string  `json:"imageUrl"`
+	SubmittedByUserID string  `json:"submittedByUserId"`
+	Approved          bool    `json:"approved"`
+	ApprovedByUserID  string  `json:"approvedByUserId,omitempty"`
+	ApprovedAt        *string `json:"approvedAt,omitempty"`
+	CreatedAt         string  `json:"createdAt"`
 }
 
 // File はファイル情報です
@@ -438,6 +905,9 @@ type File struct {
 	Extension string       `json:"extension"`
 	Tags     []string      `json:"tags"`
 	Versions []FileVersion `json:"versions"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // FileVersion はファイルのバージョン情報です
@@ -457,29 +927,91 @@ type FileDescriptor struct {
 	MD5        string `json:"md5"`
 	SizeInMB   int    `json:"sizeInMb"`
 	Status     string `json:"status"`
+
+	// アップロード時に使用されるフィールド
+	Category    string `json:"category"` // "simple", "multipart", "queued"
+	FileName    string `json:"fileName"`
+	SizeInBytes int64  `json:"sizeInBytes"`
+	UploadID    string `json:"uploadId"`
 }
 
 // FileVersionSignature はファイルバージョンの署名です
 type FileVersionSignature struct {
+	FileDescriptor
 	Signature string `json:"signature"`
 	Algorithm string `json:"algorithm"`
 }
 
 // FileVersionDelta はファイルバージョンのデルタです
 type FileVersionDelta struct {
+	FileDescriptor
 	Server   string `json:"server"`
 	Signature string `json:"signature"`
 }
 
 // PlayerModeration はプレイヤーモデレーション情報です
 type PlayerModeration struct {
-	ID                 string `json:"id"`
-	Type               string `json:"type"` // "mute", "unmute", "block", "unblock", "hideAvatar", "showAvatar"
-	SourceUserID       string `json:"sourceUserId"`
-	SourceDisplayName  string `json:"sourceDisplayName"`
-	TargetUserID       string `json:"targetUserId"`
-	TargetDisplayName  string `json:"targetDisplayName"`
-	Created            string `json:"created"`
+	ID                string         `json:"id"`
+	Type              ModerationType `json:"type"`
+	SourceUserID      string         `json:"sourceUserId"`
+	SourceDisplayName string         `json:"sourceDisplayName"`
+	TargetUserID      string         `json:"targetUserId"`
+	TargetDisplayName string         `json:"targetDisplayName"`
+	Created           string         `json:"created"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ModerationType はプレイヤーモデレーションの種類です
+type ModerationType string
+
+const (
+	ModerationTypeMute        ModerationType = "mute"
+	ModerationTypeUnmute      ModerationType = "unmute"
+	ModerationTypeBlock       ModerationType = "block"
+	ModerationTypeUnblock     ModerationType = "unblock"
+	ModerationTypeHideAvatar  ModerationType = "hideAvatar"
+	ModerationTypeShowAvatar  ModerationType = "showAvatar"
+	ModerationTypeInteractOn  ModerationType = "interactOn"
+	ModerationTypeInteractOff ModerationType = "interactOff"
+)
+
+// GetPlayerModerationsOptions はプレイヤーモデレーション取得のオプションです
+type GetPlayerModerationsOptions struct {
+	// Type は取得するモデレーションの種類です（空の場合はすべて）
+	Type ModerationType
+	// TargetUserID は対象ユーザーのIDです（空の場合はすべて）
+	TargetUserID string
+}
+
+// SuccessResponse は処理結果のみを返すAPIのレスポンスです
+type SuccessResponse struct {
+	Success struct {
+		Message    string `json:"message"`
+		StatusCode int    `json:"status_code"`
+	} `json:"success"`
+}
+
+// ModerationAction はモデレーションの同期で行う操作です
+type ModerationAction string
+
+const (
+	ModerationActionModerate   ModerationAction = "moderate"
+	ModerationActionUnmoderate ModerationAction = "unmoderate"
+)
+
+// ModerationChange はモデレーションの同期で行う1件の変更です
+type ModerationChange struct {
+	Action ModerationAction
+	Type   ModerationType
+	UserID string
+}
+
+// SyncPlayerModerationsOptions はプレイヤーモデレーション同期のオプションです
+type SyncPlayerModerationsOptions struct {
+	// DryRun が true の場合は変更を行わず、必要な変更の一覧だけを返します
+	DryRun bool
 }
 
 // Announcement はお知らせです
@@ -510,7 +1042,7 @@ type DynamicWorldRow struct {
 // Config はシステム設定です
 type Config struct {
 	// システム設定に関する80以上のフィールド
-	// ここでは基本的なフィールドのみ記載
+	// ここでは基本的なフィールドのみ記載し、残りは Extra に保持する
 	Address              string             `json:"address"`
 	APIKey               string             `json:"apiKey"`
 	AppName              string             `json:"appName"`
@@ -522,6 +1054,9 @@ type Config struct {
 	Announcements        []Announcement     `json:"announcements"`
 	DownloadUrls         DownloadUrls       `json:"downloadUrls"`
 	DynamicWorldRows     []DynamicWorldRow  `json:"dynamicWorldRows"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // InfoPush は情報プッシュです
@@ -533,6 +1068,9 @@ type InfoPush struct {
 	Data          InfoPushData `json:"data"`
 	StartDate     string       `json:"startDate"`
 	EndDate       string       `json:"endDate"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // InfoPushData は情報プッシュのデータです
@@ -564,6 +1102,38 @@ type SearchAvatarsOptions struct {
 	Platform        string
 }
 
+// AvatarRequest はアバターの作成リクエストです
+type AvatarRequest struct {
+	ID              string   `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ImageURL        string   `json:"imageUrl,omitempty"`
+	ReleaseStatus   string   `json:"releaseStatus,omitempty"`
+	AssetURL        string   `json:"assetUrl,omitempty"`
+	AssetVersion    int      `json:"assetVersion,omitempty"`
+	Platform        string   `json:"platform,omitempty"`
+	UnityPackageURL string   `json:"unityPackageUrl,omitempty"`
+	UnityVersion    string   `json:"unityVersion,omitempty"`
+	Version         int      `json:"version,omitempty"`
+}
+
+// UpdateAvatarRequest はアバターの更新リクエストです。
+// nil のフィールドは変更されません。
+type UpdateAvatarRequest struct {
+	Name            *string   `json:"name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Tags            *[]string `json:"tags,omitempty"`
+	ImageURL        *string   `json:"imageUrl,omitempty"`
+	ReleaseStatus   *string   `json:"releaseStatus,omitempty"`
+	AssetURL        *string   `json:"assetUrl,omitempty"`
+	AssetVersion    *int      `json:"assetVersion,omitempty"`
+	Platform        *string   `json:"platform,omitempty"`
+	UnityPackageURL *string   `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string   `json:"unityVersion,omitempty"`
+	Version         *int      `json:"version,omitempty"`
+}
+
 // SearchWorldsOptions はワールド検索のオプションです
 type SearchWorldsOptions struct {
 	Featured        bool
@@ -580,6 +1150,51 @@ type SearchWorldsOptions struct {
 	Platform        string
 }
 
+// WorldRequest はワールドの作成リクエストです
+type WorldRequest struct {
+	ID              string   `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ImageURL        string   `json:"imageUrl,omitempty"`
+	ReleaseStatus   string   `json:"releaseStatus,omitempty"`
+	Capacity        int      `json:"capacity,omitempty"`
+	AuthorID        string   `json:"authorId,omitempty"`
+	AuthorName      string   `json:"authorName,omitempty"`
+	AssetURL        string   `json:"assetUrl,omitempty"`
+	AssetVersion    int      `json:"assetVersion,omitempty"`
+	Platform        string   `json:"platform,omitempty"`
+	UnityPackageURL string   `json:"unityPackageUrl,omitempty"`
+	UnityVersion    string   `json:"unityVersion,omitempty"`
+}
+
+// UpdateWorldRequest はワールドの更新リクエストです。
+// nil のフィールドは変更されません。
+type UpdateWorldRequest struct {
+	Name            *string   `json:"name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Tags            *[]string `json:"tags,omitempty"`
+	ImageURL        *string   `json:"imageUrl,omitempty"`
+	ReleaseStatus   *string   `json:"releaseStatus,omitempty"`
+	Capacity        *int      `json:"capacity,omitempty"`
+	AssetURL        *string   `json:"assetUrl,omitempty"`
+	AssetVersion    *int      `json:"assetVersion,omitempty"`
+	Platform        *string   `json:"platform,omitempty"`
+	UnityPackageURL *string   `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string   `json:"unityVersion,omitempty"`
+}
+
+// WorldPublishStatus はワールドの公開可否です
+type WorldPublishStatus struct {
+	CanPublish bool `json:"canPublish"`
+}
+
+// WorldMetadata はワールドのメタデータです
+type WorldMetadata struct {
+	ID       string                     `json:"id"`
+	Metadata map[string]json.RawMessage `json:"metadata"`
+}
+
 // GetFriendsOptions はフレンドリスト取得のオプションです
 type GetFriendsOptions struct {
 	Offset  int
@@ -623,3 +1238,652 @@ type SendNotificationRequest struct {
 	Message    string                 `json:"message,omitempty"`
 	Details    map[string]interface{} `json:"details,omitempty"`
 }
+
+// SchemaDriftKind はスキーマ差分の種類です
+type SchemaDriftKind string
+
+const (
+	// SchemaDriftNewField はモデルに定義されていないフィールドがレスポンスに含まれていたことを示します
+	SchemaDriftNewField SchemaDriftKind = "new"
+	// SchemaDriftMissingField はモデルで必須のフィールドがレスポンスに含まれていなかったことを示します
+	SchemaDriftMissingField SchemaDriftKind = "missing"
+	// SchemaDriftKindMismatch はフィールドのJSONの型がモデルと異なっていたことを示します
+	SchemaDriftKindMismatch SchemaDriftKind = "kind"
+)
+
+// SchemaDrift はAPIレスポンスとGoのモデルとの差分です
+type SchemaDrift struct {
+	Endpoint string          // "GET /users/{id}" のように正規化されたエンドポイント
+	Field    string          // "unityPackages[].platform" のようなフィールドのパス
+	Kind     SchemaDriftKind
+	Expected string          // モデルが期待するJSONの型（"string", "object" など）
+	Actual   string          // レスポンスに含まれていたJSONの型
+}
+
+// BulkOptions は複数のリソースを一括取得する際のオプションです
+type BulkOptions[T any] struct {
+	// Concurrency は同時に実行するリクエスト数です（0 の場合は 8）
+	Concurrency int
+	// OnResult は各IDの取得が完了するたびに呼び出されます。呼び出しは直列化されます。
+	OnResult func(id string, result *T, err error)
+}
+
+// UploadFileOptions はファイルアップロードのオプションです
+type UploadFileOptions struct {
+	// MimeType はアップロードするファイルのContent-Typeです（省略時はファイルの mimeType）
+	MimeType string
+	// Signature はファイルの librsync 形式の署名です（nil の場合は自動生成）
+	Signature     io.ReaderAt
+	SignatureSize int64
+	// PartSize はマルチパートアップロードのパートサイズです（0 の場合は 10MiB）。
+	// 途中から再開する場合はアップロード済みのパートのサイズが優先されます。
+	PartSize int64
+	// PollInterval はアップロード完了後に処理状況を確認する間隔です（0 の場合は 2秒）
+	PollInterval time.Duration
+	// OnProgress はアップロードの進捗を受け取ります
+	OnProgress func(progress UploadProgress)
+}
+
+// UploadProgress はファイルアップロードの進捗です
+type UploadProgress struct {
+	FileType      FileDataType // FileDataTypeFile または FileDataTypeSignature
+	UploadedBytes int64
+	TotalBytes    int64
+}
+
+// ImageTag はユーザー画像の種類を示すタグです
+type ImageTag string
+
+const (
+	ImageTagGallery       ImageTag = "gallery"
+	ImageTagIcon          ImageTag = "icon"
+	ImageTagEmoji         ImageTag = "emoji"
+	ImageTagEmojiAnimated ImageTag = "emojianimated"
+	ImageTagSticker       ImageTag = "sticker"
+)
+
+// UploadImageOptions はユーザー画像アップロードのオプションです
+type UploadImageOptions struct {
+	Tag      ImageTag
+	FileName string // 省略時は "image.png" など形式に応じた名前
+
+	// エモジ・ステッカー用
+	AnimationStyle string // "aura", "bats", "bees" など
+	MaskTag        string // "square", "circle" など
+
+	// アニメーションエモジ用（スプライトシートのフレーム数と1秒あたりのフレーム数）
+	Frames         int
+	FramesOverTime int
+}
+
+// FileDataType はファイルバージョン内のデータの種類です
+type FileDataType string
+
+const (
+	FileDataTypeFile      FileDataType = "file"
+	FileDataTypeSignature FileDataType = "signature"
+	FileDataTypeDelta     FileDataType = "delta"
+)
+
+// CreateFileRequest はファイル作成リクエストです
+type CreateFileRequest struct {
+	Name      string   `json:"name"`
+	MimeType  string   `json:"mimeType"`
+	Extension string   `json:"extension"`
+	Tags      []string `json:"tags,omitempty"`
+}
+
+// CreateFileVersionRequest はファイルバージョン作成リクエストです。
+// MD5 はBase64でエンコードした値を指定します。
+type CreateFileVersionRequest struct {
+	SignatureMD5         string `json:"signatureMd5"`
+	SignatureSizeInBytes int64  `json:"signatureSizeInBytes"`
+	FileMD5              string `json:"fileMd5,omitempty"`
+	FileSizeInBytes      int64  `json:"fileSizeInBytes,omitempty"`
+}
+
+// FinishFileDataUploadRequest はファイルデータのアップロード完了リクエストです
+type FinishFileDataUploadRequest struct {
+	ETags          []string `json:"etags,omitempty"` // マルチパートアップロードの各パートのETag
+	NextPartNumber string   `json:"nextPartNumber"`
+	MaxParts       string   `json:"maxParts"`
+}
+
+// FileUploadURL はアップロード先の署名付きURLです
+type FileUploadURL struct {
+	URL string `json:"url"`
+}
+
+// FileVersionUploadStatus はマルチパートアップロードの進捗状況です
+type FileVersionUploadStatus struct {
+	UploadID       string           `json:"uploadId"`
+	FileName       string           `json:"fileName"`
+	NextPartNumber int              `json:"nextPartNumber"`
+	MaxParts       int              `json:"maxParts"`
+	Parts          []FileUploadPart `json:"parts"`
+	ETags          []string         `json:"etags"`
+}
+
+// FileUploadPart はマルチパートアップロードでアップロード済みのパートです
+type FileUploadPart struct {
+	PartNumber int    `json:"partNumber"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+}
+
+// PartSize はアップロード済みの最初のパートのサイズを返します。
+// サイズが分からない場合は 0 を返します。
+func (s FileVersionUploadStatus) PartSize() int64 {
+	for _, part := range s.Parts {
+		if part.PartNumber == 1 {
+			return part.Size
+		}
+	}
+	return 0
+}
+
+// GetFilesOptions はファイルリスト取得のオプションです
+type GetFilesOptions struct {
+	Tag    string
+	UserID string
+	N      int
+	Offset int
+}
+
+// GroupRole はグループのロールです
+type GroupRole struct {
+	ID                string            `json:"id"`
+	GroupID           string            `json:"groupId"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	IsSelfAssignable  bool              `json:"isSelfAssignable"`
+	Permissions       []GroupPermission `json:"permissions"`
+	IsManagementRole  bool              `json:"isManagementRole"`
+	RequiresTwoFactor bool              `json:"requiresTwoFactor"`
+	RequiresPurchase  bool              `json:"requiresPurchase"`
+	Order             int               `json:"order"`
+	CreatedAt         string            `json:"createdAt"`
+	UpdatedAt         string            `json:"updatedAt"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CreateGroupRoleRequest はグループロール作成リクエストです
+type CreateGroupRoleRequest struct {
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	IsSelfAssignable bool              `json:"isSelfAssignable,omitempty"`
+	Permissions      []GroupPermission `json:"permissions,omitempty"`
+}
+
+// UpdateGroupRoleRequest はグループロール更新リクエストです
+type UpdateGroupRoleRequest struct {
+	Name             *string           `json:"name,omitempty"`
+	Description      *string           `json:"description,omitempty"`
+	IsSelfAssignable *bool             `json:"isSelfAssignable,omitempty"`
+	Permissions      []GroupPermission `json:"permissions,omitempty"`
+	Order            *int              `json:"order,omitempty"`
+}
+
+// GroupAuditLogEventType はグループ監査ログのイベント種別です
+type GroupAuditLogEventType string
+
+const (
+	GroupAuditEventMemberJoin         GroupAuditLogEventType = "group.member.join"
+	GroupAuditEventMemberLeave        GroupAuditLogEventType = "group.member.leave"
+	GroupAuditEventMemberRemove       GroupAuditLogEventType = "group.member.remove"
+	GroupAuditEventMemberUpdate       GroupAuditLogEventType = "group.member.user.update"
+	GroupAuditEventMemberRoleAssign   GroupAuditLogEventType = "group.member.role.assign"
+	GroupAuditEventMemberRoleUnassign GroupAuditLogEventType = "group.member.role.unassign"
+	GroupAuditEventUserBan            GroupAuditLogEventType = "group.user.ban"
+	GroupAuditEventUserUnban          GroupAuditLogEventType = "group.user.unban"
+	GroupAuditEventRoleCreate         GroupAuditLogEventType = "group.role.create"
+	GroupAuditEventRoleUpdate         GroupAuditLogEventType = "group.role.update"
+	GroupAuditEventRoleDelete         GroupAuditLogEventType = "group.role.delete"
+	GroupAuditEventInviteCreate       GroupAuditLogEventType = "group.invite.create"
+	GroupAuditEventInviteCancel       GroupAuditLogEventType = "group.invite.cancel"
+	GroupAuditEventRequestCreate      GroupAuditLogEventType = "group.request.create"
+	GroupAuditEventRequestReject      GroupAuditLogEventType = "group.request.reject"
+	GroupAuditEventRequestBlock       GroupAuditLogEventType = "group.request.block"
+	GroupAuditEventUpdate             GroupAuditLogEventType = "group.update"
+	GroupAuditEventInstanceCreate     GroupAuditLogEventType = "group.instance.create"
+	GroupAuditEventInstanceClose      GroupAuditLogEventType = "group.instance.close"
+	GroupAuditEventInstanceKick       GroupAuditLogEventType = "group.instance.kick"
+	GroupAuditEventInstanceWarn       GroupAuditLogEventType = "group.instance.warn"
+	GroupAuditEventAnnouncementCreate GroupAuditLogEventType = "group.announcement.create"
+	GroupAuditEventAnnouncementDelete GroupAuditLogEventType = "group.announcement.delete"
+	GroupAuditEventPostCreate         GroupAuditLogEventType = "group.post.create"
+	GroupAuditEventPostDelete         GroupAuditLogEventType = "group.post.delete"
+)
+
+// GroupAuditLogEntry はグループ監査ログのエントリです
+type GroupAuditLogEntry struct {
+	ID               string                 `json:"id"`
+	CreatedAt        string                 `json:"created_at"`
+	GroupID          string                 `json:"groupId"`
+	ActorID          string                 `json:"actorId"`
+	ActorDisplayName string                 `json:"actorDisplayName"`
+	TargetID         string                 `json:"targetId"`
+	EventType        GroupAuditLogEventType `json:"eventType"`
+	Description      string                 `json:"description"`
+	Data             json.RawMessage        `json:"data,omitempty"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GroupAuditLogPage はグループ監査ログの1ページ分の結果です
+type GroupAuditLogPage struct {
+	Results    []GroupAuditLogEntry `json:"results"`
+	TotalCount int                  `json:"totalCount"`
+	HasNext    bool                 `json:"hasNext"`
+}
+
+// GetGroupAuditLogsOptions はグループ監査ログ取得のオプションです
+type GetGroupAuditLogsOptions struct {
+	// StartDate 以降のエントリのみを取得します（ゼロ値の場合は指定なし）
+	StartDate time.Time
+	// EndDate 以前のエントリのみを取得します（ゼロ値の場合は指定なし）
+	EndDate time.Time
+	// EventTypes は取得するイベント種別です（空の場合はすべて）
+	EventTypes []GroupAuditLogEventType
+	// ActorIDs は操作を行ったユーザーのIDです（空の場合はすべて）
+	ActorIDs []string
+	// TargetIDs は操作対象のIDです（空の場合はすべて）
+	TargetIDs []string
+	// N は取得件数です（0 の場合は 60）
+	N      int
+	Offset int
+}
+
+// GroupAuditCursor はグループ監査ログの追跡位置です。
+// JSON にエンコードして永続化し、次回の追跡開始時に渡すことができます。
+type GroupAuditCursor struct {
+	// Since は処理済みの最新エントリの作成日時です
+	Since time.Time `json:"since"`
+	// SeenIDs は Since と同じ作成日時を持つ処理済みエントリのIDです
+	SeenIDs []string `json:"seenIds,omitempty"`
+}
+
+// TailGroupAuditLogsOptions はグループ監査ログ追跡のオプションです
+type TailGroupAuditLogsOptions struct {
+	// Cursor は追跡を開始する位置です（ゼロ値の場合はすべてのエントリが対象）
+	Cursor GroupAuditCursor
+	// PollInterval はポーリング間隔です（0 の場合は30秒）
+	PollInterval time.Duration
+	// EventTypes は追跡するイベント種別です（空の場合はすべて）
+	EventTypes []GroupAuditLogEventType
+	// ActorIDs は追跡する操作ユーザーのIDです（空の場合はすべて）
+	ActorIDs []string
+	// OnEntry は新しいエントリごとに古い順で呼び出されます。エラーを返すと追跡を終了します。
+	OnEntry func(entry GroupAuditLogEntry) error
+	// OnCursor はエントリの処理後に更新された追跡位置で呼び出されます。永続化に使用します。
+	OnCursor func(cursor GroupAuditCursor) error
+}
+
+// InviteMessageType は招待メッセージの種類です
+type InviteMessageType string
+
+const (
+	// InviteMessageTypeMessage は招待を送信する際のメッセージです
+	InviteMessageTypeMessage InviteMessageType = "message"
+	// InviteMessageTypeRequest は招待をリクエストする際のメッセージです
+	InviteMessageTypeRequest InviteMessageType = "request"
+	// InviteMessageTypeResponse は招待に応答する際のメッセージです
+	InviteMessageTypeResponse InviteMessageType = "response"
+	// InviteMessageTypeRequestResponse は招待リクエストに応答する際のメッセージです
+	InviteMessageTypeRequestResponse InviteMessageType = "requestResponse"
+)
+
+// InviteMessage は招待メッセージのテンプレートです
+type InviteMessage struct {
+	ID                       string            `json:"id"`
+	Message                  string            `json:"message"`
+	MessageType              InviteMessageType `json:"messageType"`
+	Slot                     int               `json:"slot"`
+	CanBeUpdated             bool              `json:"canBeUpdated"`
+	RemainingCooldownMinutes int               `json:"remainingCooldownMinutes"`
+	UpdatedAt                string            `json:"updatedAt"`
+}
+
+// InviteUserRequest はインスタンスへの招待リクエストです
+type InviteUserRequest struct {
+	// InstanceID は招待先のロケーション（worldId:instanceId）です
+	InstanceID string `json:"instanceId"`
+	// MessageSlot は添付する招待メッセージのスロットです（nil の場合はメッセージなし）
+	MessageSlot *int `json:"messageSlot,omitempty"`
+}
+
+// RequestInviteRequest は招待のリクエストです
+type RequestInviteRequest struct {
+	// RequestSlot は添付する招待リクエストメッセージのスロットです（nil の場合はメッセージなし）
+	RequestSlot *int `json:"requestSlot,omitempty"`
+}
+
+// RespondInviteRequest は招待への応答リクエストです
+type RespondInviteRequest struct {
+	// ResponseSlot は応答に使用する招待応答メッセージのスロットです
+	ResponseSlot int `json:"responseSlot"`
+}
+
+// UpdateInviteMessageRequest は招待メッセージ更新リクエストです
+type UpdateInviteMessageRequest struct {
+	Message string `json:"message"`
+}
+
+// CalendarEventCategory はカレンダーイベントのカテゴリです
+type CalendarEventCategory string
+
+const (
+	CalendarEventCategoryMusic       CalendarEventCategory = "music"
+	CalendarEventCategoryGaming      CalendarEventCategory = "gaming"
+	CalendarEventCategoryHangout     CalendarEventCategory = "hangout"
+	CalendarEventCategoryExploring   CalendarEventCategory = "exploring"
+	CalendarEventCategoryAvatars     CalendarEventCategory = "avatars"
+	CalendarEventCategoryFilmMedia   CalendarEventCategory = "film_media"
+	CalendarEventCategoryDance       CalendarEventCategory = "dance"
+	CalendarEventCategoryRoleplaying CalendarEventCategory = "roleplaying"
+	CalendarEventCategoryPerformance CalendarEventCategory = "performance"
+	CalendarEventCategoryWellness    CalendarEventCategory = "wellness"
+	CalendarEventCategoryArts        CalendarEventCategory = "arts"
+	CalendarEventCategoryEducation   CalendarEventCategory = "education"
+	CalendarEventCategoryOther       CalendarEventCategory = "other"
+)
+
+// CalendarEventAccessType はカレンダーイベントの公開範囲です
+type CalendarEventAccessType string
+
+const (
+	CalendarEventAccessPublic CalendarEventAccessType = "public"
+	CalendarEventAccessGroup  CalendarEventAccessType = "group"
+)
+
+// CalendarEvent はグループのカレンダーイベントです
+type CalendarEvent struct {
+	ID                  string                  `json:"id"`
+	OwnerID             string                  `json:"ownerId"` // イベントを開催するグループのID
+	Title               string                  `json:"title"`
+	Description         string                  `json:"description"`
+	StartsAt            string                  `json:"startsAt"`
+	EndsAt              string                  `json:"endsAt"`
+	Category            CalendarEventCategory   `json:"category"`
+	AccessType          CalendarEventAccessType `json:"accessType"`
+	Platforms           []string                `json:"platforms"`
+	Languages           []string                `json:"languages"`
+	Tags                []string                `json:"tags"`
+	RoleIDs             []string                `json:"roleIds"`
+	ImageID             string                  `json:"imageId"`
+	ImageURL            string                  `json:"imageUrl"`
+	IsDraft             bool                    `json:"isDraft"`
+	Featured            bool                    `json:"featured"`
+	InterestedUserCount int                     `json:"interestedUserCount"`
+	UserInterest        *struct {
+		IsFollowing bool   `json:"isFollowing"`
+		CreatedAt   string `json:"createdAt"`
+	} `json:"userInterest,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+	DeletedAt *string `json:"deletedAt,omitempty"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// StartTime はイベントの開始日時を解析して返します
+func (e CalendarEvent) StartTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.StartsAt)
+}
+
+// EndTime はイベントの終了日時を解析して返します
+func (e CalendarEvent) EndTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.EndsAt)
+}
+
+// CalendarEventPage はカレンダーイベントの1ページ分の結果です
+type CalendarEventPage struct {
+	Results    []CalendarEvent `json:"results"`
+	TotalCount int             `json:"totalCount"`
+	HasNext    bool            `json:"hasNext"`
+}
+
+// GetCalendarEventsOptions はカレンダーイベント取得のオプションです
+type GetCalendarEventsOptions struct {
+	// Date はこの日時を含む月のイベントを取得します（ゼロ値の場合は現在）
+	Date time.Time
+	// N は取得件数です（0 の場合は 60）
+	N      int
+	Offset int
+}
+
+// CalendarEventRequest はカレンダーイベントの作成・更新リクエストです
+type CalendarEventRequest struct {
+	Title                    string                  `json:"title"`
+	Description              string                  `json:"description"`
+	StartsAt                 time.Time               `json:"startsAt"`
+	EndsAt                   time.Time               `json:"endsAt"`
+	Category                 CalendarEventCategory   `json:"category"`
+	AccessType               CalendarEventAccessType `json:"accessType"`
+	Platforms                []string                `json:"platforms,omitempty"`
+	Languages                []string                `json:"languages,omitempty"`
+	Tags                     []string                `json:"tags,omitempty"`
+	RoleIDs                  []string                `json:"roleIds,omitempty"`
+	ImageID                  string                  `json:"imageId,omitempty"`
+	IsDraft                  bool                    `json:"isDraft,omitempty"`
+	SendCreationNotification bool                    `json:"sendCreationNotification,omitempty"`
+}
+
+// Print はVRChat内で撮影・印刷されたプリントです
+type Print struct {
+	ID         string `json:"id"`
+	OwnerID    string `json:"ownerId"`
+	AuthorID   string `json:"authorId"`
+	AuthorName string `json:"authorName"`
+	Note       string `json:"note"`
+	WorldID    string `json:"worldId"`
+	WorldName  string `json:"worldName"`
+	Timestamp  string `json:"timestamp"`
+	CreatedAt  string `json:"createdAt"`
+	Files      struct {
+		FileID string `json:"fileId"`
+		Image  string `json:"image"`
+	} `json:"files"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UploadPrintOptions はプリントのアップロードのオプションです
+type UploadPrintOptions struct {
+	// Note はプリントに添えるメモです
+	Note string
+	// WorldID と WorldName は撮影したワールドの情報です
+	WorldID   string
+	WorldName string
+	// Timestamp は撮影日時です（ゼロ値の場合は現在）
+	Timestamp time.Time
+	// FileName はアップロードするファイル名です（省略時は "print.png" など）
+	FileName string
+}
+
+// InventoryItemType はインベントリアイテムの種類です
+type InventoryItemType string
+
+const (
+	InventoryItemTypeEmoji   InventoryItemType = "emoji"
+	InventoryItemTypeSticker InventoryItemType = "sticker"
+	InventoryItemTypeProp    InventoryItemType = "prop"
+	InventoryItemTypeBundle  InventoryItemType = "bundle"
+)
+
+// InventoryItem はインベントリのアイテムです
+type InventoryItem struct {
+	ID            string            `json:"id"`
+	HolderID      string            `json:"holderId"`
+	TemplateID    string            `json:"templateId"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	ItemType      InventoryItemType `json:"itemType"`
+	ItemTypeLabel string            `json:"itemTypeLabel"`
+	ImageURL      string            `json:"imageUrl"`
+	Tags          []string          `json:"tags"`
+	Flags         []string          `json:"flags"`
+	Metadata      json.RawMessage   `json:"metadata,omitempty"`
+	IsArchived    bool              `json:"isArchived"`
+	IsSeen        bool              `json:"isSeen"`
+	ExpiryDate    *string           `json:"expiryDate,omitempty"`
+	CreatedAt     string            `json:"created_at"`
+	UpdatedAt     string            `json:"updated_at"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GetInventoryOptions はインベントリ取得のオプションです
+type GetInventoryOptions struct {
+	// Types は取得するアイテムの種類です（空の場合はすべて）
+	Types []InventoryItemType
+	// Tags は取得するアイテムのタグです（空の場合はすべて）
+	Tags []string
+	// Order は並び順です（"newest" または "oldest"）
+	Order string
+	// N は取得件数です（0 の場合は 100）
+	N      int
+	Offset int
+}
+
+// InventoryPage はインベントリの1ページ分の結果です
+type InventoryPage struct {
+	Data       []InventoryItem `json:"data"`
+	TotalCount int             `json:"totalCount"`
+}
+
+// supporterTag は VRC+ サポーターのユーザーに付与されるタグです
+const supporterTag = "system_supporter"
+
+// IsSupporter はタグから VRC+ サポーターであるかを判定します。
+// 他のユーザーのサブスクリプションは取得できないため、User.Tags などを渡して確認します。
+func IsSupporter(tags []string) bool {
+	for _, tag := range tags {
+		if tag == supporterTag {
+			return true
+		}
+	}
+	return false
+}
+
+// UserSubscription は現在のユーザーのサブスクリプションです
+type UserSubscription struct {
+	ID            string   `json:"id"`
+	TransactionID string   `json:"transactionId"`
+	Store         string   `json:"store"`
+	SteamItemID   string   `json:"steamItemId,omitempty"`
+	Amount        float64  `json:"amount"`
+	Description   string   `json:"description"`
+	Period        string   `json:"period"`
+	Tier          int      `json:"tier"`
+	Active        bool     `json:"active"`
+	Status        string   `json:"status"`
+	Starts        string   `json:"starts,omitempty"`
+	Expires       string   `json:"expires"`
+	LicenseGroups []string `json:"licenseGroups"`
+	IsGift        bool     `json:"isGift"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
+
+// IsActiveAt はサブスクリプションが指定された日時に有効かを判定します。
+// 有効期限が空または解析できない場合は有効とみなしません。
+func (s UserSubscription) IsActiveAt(t time.Time) bool {
+	if !s.Active {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, s.Expires)
+	if err != nil {
+		return false
+	}
+	return t.Before(expires)
+}
+
+// Subscription は購入可能なサブスクリプション商品です
+type Subscription struct {
+	ID              string  `json:"id"`
+	SteamItemID     string  `json:"steamItemId"`
+	OculusSku       string  `json:"oculusSku,omitempty"`
+	GoogleProductID string  `json:"googleProductId,omitempty"`
+	AppleProductID  string  `json:"appleProductId,omitempty"`
+	Amount          float64 `json:"amount"`
+	Description     string  `json:"description"`
+	Period          string  `json:"period"`
+	Tier            int     `json:"tier"`
+}
+
+// LicenseGroup はライセンスグループです
+type LicenseGroup struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Licenses    []License `json:"licenses"`
+}
+
+// License はライセンスグループに含まれるライセンスです
+type License struct {
+	ForID     string `json:"forId"`
+	ForType   string `json:"forType"`
+	ForName   string `json:"forName"`
+	ForAction string `json:"forAction"`
+}
+
+// ProductListing は販売中の商品です
+type ProductListing struct {
+	ID                string           `json:"id"`
+	SellerID          string           `json:"sellerId"`
+	SellerDisplayName string           `json:"sellerDisplayName"`
+	DisplayName       string           `json:"displayName"`
+	Description       string           `json:"description"`
+	ListingType       string           `json:"listingType"`
+	PriceTokens       int              `json:"priceTokens"`
+	Duration          int              `json:"duration,omitempty"`
+	DurationType      string           `json:"durationType,omitempty"`
+	Active            bool             `json:"active"`
+	GroupID           string           `json:"groupId,omitempty"`
+	ImageID           string           `json:"imageId,omitempty"`
+	Tags              []string         `json:"tags"`
+	Products          []ListingProduct `json:"products"`
+	CreatedAt         string           `json:"created_at"`
+	UpdatedAt         string           `json:"updated_at"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ListingProduct は商品に含まれるプロダクトです
+type ListingProduct struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	ProductType string `json:"productType"`
+}
+
+// Balance はユーザーのトークン残高です
+type Balance struct {
+	Balance        int  `json:"balance"`
+	NoTransactions bool `json:"noTransactions"`
+}
+
+// Transaction はストアでの購入履歴です
+type Transaction struct {
+	ID              string        `json:"id"`
+	UserID          string        `json:"userId"`
+	UserDisplayName string        `json:"userDisplayName"`
+	Status          string        `json:"status"`
+	Subscription    *Subscription `json:"subscription,omitempty"`
+	Sandbox         bool          `json:"sandbox"`
+	IsGift          bool          `json:"isGift"`
+	IsTokens        bool          `json:"isTokens"`
+	Error           string        `json:"error,omitempty"`
+	CreatedAt       string        `json:"created_at"`
+	UpdatedAt       string        `json:"updated_at"`
+
+	// Extra はモデルで定義されていない未知のフィールドを保持します
+	Extra map[string]json.RawMessage `json:"-"`
+}
@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserSubscriptionIsActiveAt(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		sub  UserSubscription
+		want bool
+	}{
+		{name: "active", sub: UserSubscription{Active: true, Expires: "2026-07-01T00:00:00.000Z"}, want: true},
+		{name: "expired", sub: UserSubscription{Active: true, Expires: "2026-05-01T00:00:00.000Z"}, want: false},
+		{name: "expires now", sub: UserSubscription{Active: true, Expires: "2026-06-01T00:00:00Z"}, want: false},
+		{name: "inactive", sub: UserSubscription{Active: false, Expires: "2026-07-01T00:00:00Z"}, want: false},
+		// 有効期限が不明な場合は有効とみなさない
+		{name: "empty expires", sub: UserSubscription{Active: true}, want: false},
+		{name: "invalid expires", sub: UserSubscription{Active: true, Expires: "next month"}, want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.sub.IsActiveAt(now); got != tt.want {
+			t.Errorf("%s: IsActiveAt() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -21,6 +21,28 @@ func (c *Client) GetAvatar(ctx context.Context, avatarID string) (*shared.Avatar
 
 // SearchAvatars はアバターを検索します
 func (c *Client) SearchAvatars(ctx context.Context, opts shared.SearchAvatarsOptions) ([]shared.Avatar, error) {
+	var avatars []shared.Avatar
+	path := "/avatars?" + avatarSearchParams(opts).Encode()
+	err := c.doRequest(ctx, "GET", path, nil, &avatars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search avatars: %w", err)
+	}
+	return avatars, nil
+}
+
+// ListFavoritedAvatars はお気に入りに登録したアバターのリストを取得します
+func (c *Client) ListFavoritedAvatars(ctx context.Context, opts shared.SearchAvatarsOptions) ([]shared.Avatar, error) {
+	var avatars []shared.Avatar
+	path := "/avatars/favorites?" + avatarSearchParams(opts).Encode()
+	err := c.doRequest(ctx, "GET", path, nil, &avatars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorited avatars: %w", err)
+	}
+	return avatars, nil
+}
+
+// avatarSearchParams はアバター検索のクエリパラメータを作成します
+func avatarSearchParams(opts shared.SearchAvatarsOptions) url.Values {
 	params := url.Values{}
 	if opts.Featured {
 		params.Set("featured", "true")
@@ -57,18 +79,57 @@ func (c *Client) SearchAvatars(ctx context.Context, opts shared.SearchAvatarsOpt
 	if opts.Platform != "" {
 		params.Set("platform", opts.Platform)
 	}
+	return params
+}
 
-	var avatars []shared.Avatar
-	path := "/avatars"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+// CreateAvatar はアバターを作成します
+func (c *Client) CreateAvatar(ctx context.Context, req shared.AvatarRequest) (*shared.Avatar, error) {
+	var avatar shared.Avatar
+	err := c.doRequest(ctx, "POST", "/avatars", req, &avatar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create avatar: %w", err)
+	}
+	return &avatar, nil
+}
+
+// UpdateAvatar はアバター情報を更新します
+func (c *Client) UpdateAvatar(ctx context.Context, avatarID string, req shared.UpdateAvatarRequest) (*shared.Avatar, error) {
+	var avatar shared.Avatar
+	err := c.doRequest(ctx, "PUT", "/avatars/"+avatarID, req, &avatar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update avatar: %w", err)
 	}
+	return &avatar, nil
+}
 
-	err := c.doRequest(ctx, "GET", path, nil, &avatars)
+// DeleteAvatar はアバターを削除します
+func (c *Client) DeleteAvatar(ctx context.Context, avatarID string) error {
+	err := c.doRequest(ctx, "DELETE", "/avatars/"+avatarID, nil, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search avatars: %w", err)
+		return fmt.Errorf("failed to delete avatar: %w", err)
 	}
-	return avatars, nil
+	return nil
+}
+
+// GetOwnAvatar は指定されたユーザーが現在使用しているアバターを取得します
+func (c *Client) GetOwnAvatar(ctx context.Context, userID string) (*shared.Avatar, error) {
+	var avatar shared.Avatar
+	err := c.doRequest(ctx, "GET", "/users/"+userID+"/avatar", nil, &avatar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get own avatar: %w", err)
+	}
+	return &avatar, nil
+}
+
+// SelectFallbackAvatar は指定されたアバターをフォールバックアバターに設定します
+func (c *Client) SelectFallbackAvatar(ctx context.Context, avatarID string) (*shared.CurrentUser, error) {
+	var user shared.CurrentUser
+	err := c.doRequest(ctx, "PUT", "/avatars/"+avatarID+"/selectFallback", nil, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select fallback avatar: %w", err)
+	}
+	c.InvalidateCache("/users/" + user.ID)
+	return &user, nil
 }
 
 // WearAvatar は指定されたアバターを装着します
@@ -81,5 +142,7 @@ func (c *Client) WearAvatar(ctx context.Context, avatarID string) (*shared.Curre
 	if err != nil {
 		return nil, fmt.Errorf("failed to wear avatar: %w", err)
 	}
+	// 装着中のアバターが変わるため自身のユーザー情報のキャッシュを破棄
+	c.InvalidateCache("/users/" + user.ID)
 	return &user, nil
 }
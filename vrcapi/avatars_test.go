@@ -0,0 +1,67 @@
+package vrcapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestCreateAvatar(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.Avatar{ID: "avtr_1"})
+	_, err := c.CreateAvatar(context.Background(), shared.AvatarRequest{
+		Name:          "Avatar",
+		ImageURL:      "https://example.com/image.png",
+		ReleaseStatus: "private",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != "POST" || req.Path != "/avatars" {
+		t.Errorf("request = %s %s, want POST /avatars", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{
+		"name":          `"Avatar"`,
+		"imageUrl":      `"https://example.com/image.png"`,
+		"releaseStatus": `"private"`,
+	})
+}
+
+func TestUpdateAvatar(t *testing.T) {
+	empty := ""
+	public := "public"
+	version := 0
+	noTags := []string{}
+
+	tests := []struct {
+		name string
+		req  shared.UpdateAvatarRequest
+		want map[string]string
+	}{
+		{
+			name: "release status only",
+			req:  shared.UpdateAvatarRequest{ReleaseStatus: &public},
+			want: map[string]string{"releaseStatus": `"public"`},
+		},
+		{
+			// ゼロ値を指すポインタで説明文やタグを消去できる
+			name: "clear fields",
+			req:  shared.UpdateAvatarRequest{Description: &empty, Tags: &noTags, Version: &version},
+			want: map[string]string{"description": `""`, "tags": `[]`, "version": `0`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newRecordingServer(t, shared.Avatar{ID: "avtr_1"})
+			if _, err := c.UpdateAvatar(context.Background(), "avtr_1", tt.req); err != nil {
+				t.Fatal(err)
+			}
+			req := (*requests)[0]
+			if req.Method != "PUT" || req.Path != "/avatars/avtr_1" {
+				t.Errorf("request = %s %s, want PUT /avatars/avtr_1", req.Method, req.Path)
+			}
+			assertBody(t, req.Body, tt.want)
+		})
+	}
+}
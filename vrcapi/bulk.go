@@ -0,0 +1,128 @@
+package vrcapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+const (
+	// defaultBulkConcurrency は一括取得のデフォルトの同時実行数です
+	defaultBulkConcurrency = 8
+	// bulkMaxRetries はレート制限エラー時の最大リトライ回数です
+	bulkMaxRetries = 3
+	// bulkRetryDelay はレート制限エラー時の最初の待機時間です
+	bulkRetryDelay = time.Second
+)
+
+// GetUsers は複数のユーザー情報を一括取得します。
+// 取得できたユーザーはIDをキーとするマップで、失敗したIDのエラーは別のマップで返します。
+func (c *Client) GetUsers(ctx context.Context, userIDs []string, opts shared.BulkOptions[shared.User]) (map[string]*shared.User, map[string]error) {
+	return bulkFetch(ctx, userIDs, opts, c.GetUser)
+}
+
+// GetWorlds は複数のワールド情報を一括取得します
+func (c *Client) GetWorlds(ctx context.Context, worldIDs []string, opts shared.BulkOptions[shared.World]) (map[string]*shared.World, map[string]error) {
+	return bulkFetch(ctx, worldIDs, opts, c.GetWorld)
+}
+
+// GetAvatars は複数のアバター情報を一括取得します
+func (c *Client) GetAvatars(ctx context.Context, avatarIDs []string, opts shared.BulkOptions[shared.Avatar]) (map[string]*shared.Avatar, map[string]error) {
+	return bulkFetch(ctx, avatarIDs, opts, c.GetAvatar)
+}
+
+// GetGroups は複数のグループ情報を一括取得します
+func (c *Client) GetGroups(ctx context.Context, groupIDs []string, opts shared.BulkOptions[shared.Group]) (map[string]*shared.Group, map[string]error) {
+	return bulkFetch(ctx, groupIDs, opts, c.GetGroup)
+}
+
+// bulkFetch はワーカープールで ids を並行して取得します。
+// 重複したIDは1回だけ取得し、コンテキストがキャンセルされた場合は未取得のIDにそのエラーを記録します。
+func bulkFetch[T any](ctx context.Context, ids []string, opts shared.BulkOptions[T], fetch func(ctx context.Context, id string) (*T, error)) (map[string]*T, map[string]error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make(map[string]*T, len(ids))
+	errs := make(map[string]error)
+	var mu sync.Mutex
+
+	record := func(id string, result *T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[id] = err
+		} else {
+			results[id] = result
+		}
+		if opts.OnResult != nil {
+			opts.OnResult(id, result, err)
+		}
+	}
+
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				result, err := fetchWithRetry(ctx, id, fetch)
+				record(id, result, err)
+			}
+		}()
+	}
+
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		// キャンセル後に空いたワーカーへ送らないよう、送信前に確認する
+		if err := ctx.Err(); err != nil {
+			record(id, nil, err)
+			continue
+		}
+		select {
+		case queue <- id:
+		case <-ctx.Done():
+			record(id, nil, ctx.Err())
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	return results, errs
+}
+
+// fetchWithRetry はレート制限エラーの場合にリトライします。
+// レスポンスに Retry-After が指定されていればその時間、なければ指数バックオフで待機します。
+func fetchWithRetry[T any](ctx context.Context, id string, fetch func(ctx context.Context, id string) (*T, error)) (*T, error) {
+	delay := bulkRetryDelay
+	for attempt := 0; ; attempt++ {
+		result, err := fetch(ctx, id)
+		if err == nil || attempt >= bulkMaxRetries || !shared.IsRateLimitError(err) {
+			return result, err
+		}
+
+		wait := delay
+		var apiErr *shared.APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			wait = apiErr.RetryAfter
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,239 @@
+package vrcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestGetWorldsBulk(t *testing.T) {
+	var mu sync.Mutex
+	hits := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/worlds/")
+		mu.Lock()
+		hits[id]++
+		mu.Unlock()
+		if id == "wrld_missing" {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"error": map[string]any{"message": "not found", "status_code": 404}})
+			return
+		}
+		writeJSON(w, shared.World{ID: id, Name: "World " + id})
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var callbacks atomic.Int64
+	ids := []string{"wrld_a", "wrld_b", "wrld_a", "wrld_missing", "wrld_c"}
+	results, errs := c.GetWorlds(context.Background(), ids, shared.BulkOptions[shared.World]{
+		Concurrency: 2,
+		OnResult: func(string, *shared.World, error) {
+			callbacks.Add(1)
+		},
+	})
+
+	if len(results) != 3 {
+		t.Errorf("results = %d, want 3", len(results))
+	}
+	for _, id := range []string{"wrld_a", "wrld_b", "wrld_c"} {
+		if world := results[id]; world == nil || world.ID != id {
+			t.Errorf("results[%q] = %v", id, world)
+		}
+	}
+	if len(errs) != 1 || !shared.IsNotFoundError(errs["wrld_missing"]) {
+		t.Errorf("errs = %v, want not found for wrld_missing only", errs)
+	}
+	// 重複したIDは1回だけ取得する
+	if hits["wrld_a"] != 1 {
+		t.Errorf("wrld_a fetched %d times, want 1", hits["wrld_a"])
+	}
+	if got := callbacks.Load(); got != 4 {
+		t.Errorf("OnResult called %d times, want 4", got)
+	}
+}
+
+func TestBulkFetchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// キャンセル済みのコンテキストでは1件も送信しない
+	var calls atomic.Int64
+	ids := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	results, errs := bulkFetch(ctx, ids, shared.BulkOptions[string]{Concurrency: 4}, func(ctx context.Context, id string) (*string, error) {
+		calls.Add(1)
+		return &id, nil
+	})
+	if got := calls.Load(); got != 0 {
+		t.Errorf("fetch called %d times after cancel, want 0", got)
+	}
+	if len(results) != 0 || len(errs) != len(ids) {
+		t.Fatalf("results = %d, errs = %d, want 0 and %d", len(results), len(errs), len(ids))
+	}
+	for id, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("errs[%q] = %v, want context.Canceled", id, err)
+		}
+	}
+}
+
+func TestFetchWithRetry(t *testing.T) {
+	rateLimited := func(retryAfter time.Duration) error {
+		return &shared.APIError{StatusCode: http.StatusTooManyRequests, Message: "rate limited", RetryAfter: retryAfter}
+	}
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		var calls int
+		start := time.Now()
+		result, err := fetchWithRetry(context.Background(), "a", func(ctx context.Context, id string) (*string, error) {
+			calls++
+			if calls < 3 {
+				return nil, rateLimited(10 * time.Millisecond)
+			}
+			return &id, nil
+		})
+		if err != nil || *result != "a" {
+			t.Fatalf("fetchWithRetry = %v, %v", result, err)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+		// 既定の待機時間（bulkRetryDelay）ではなく Retry-After に従う
+		if elapsed := time.Since(start); elapsed >= bulkRetryDelay {
+			t.Errorf("elapsed = %v, want less than %v", elapsed, bulkRetryDelay)
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls int
+		_, err := fetchWithRetry(context.Background(), "a", func(context.Context, string) (*string, error) {
+			calls++
+			return nil, rateLimited(time.Millisecond)
+		})
+		if !shared.IsRateLimitError(err) {
+			t.Errorf("err = %v, want rate limit error", err)
+		}
+		if calls != bulkMaxRetries+1 {
+			t.Errorf("calls = %d, want %d", calls, bulkMaxRetries+1)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		var calls int
+		_, err := fetchWithRetry(context.Background(), "a", func(context.Context, string) (*string, error) {
+			calls++
+			return nil, &shared.APIError{StatusCode: http.StatusNotFound}
+		})
+		if !shared.IsNotFoundError(err) || calls != 1 {
+			t.Errorf("err = %v, calls = %d, want not found after 1 call", err, calls)
+		}
+	})
+
+	t.Run("stops waiting on cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := fetchWithRetry(ctx, "a", func(context.Context, string) (*string, error) {
+			cancel()
+			return nil, rateLimited(time.Hour)
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "3", want: 3 * time.Second},
+		{value: "0", want: 0},
+		{value: "-1", want: 0},
+		{value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second},
+		{value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		h := http.Header{}
+		if tt.value != "" {
+			h.Set("Retry-After", tt.value)
+		}
+		if got := parseRetryAfter(h, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitErrorRetryAfter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		writeJSON(w, map[string]any{"error": map[string]any{"message": "slow down", "status_code": 429}})
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = getTestWorld(context.Background(), c)
+	var apiErr *shared.APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("err = %#v, want APIError with RetryAfter 7s", err)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	if newRateLimiter(0, 5) != nil {
+		t.Error("newRateLimiter(0, 5) should be nil")
+	}
+
+	l := newRateLimiter(20, 2)
+	ctx := context.Background()
+
+	// バースト分はすぐに取得できる
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 25*time.Millisecond {
+		t.Errorf("burst took %v, want immediate", elapsed)
+	}
+
+	// 以降は 1/rate ごとに1つ補充される
+	start = time.Now()
+	if err := l.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("wait after burst took %v, want about 50ms", elapsed)
+	}
+
+	// キャンセルされた場合は予約したトークンを返却する
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := l.wait(cancelled); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait with cancelled context = %v, want context.Canceled", err)
+	}
+	l.mu.Lock()
+	tokens := l.tokens
+	l.mu.Unlock()
+	if tokens < -0.5 {
+		t.Errorf("tokens = %v after cancelled wait, want the reservation returned", tokens)
+	}
+}
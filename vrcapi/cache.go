@@ -0,0 +1,204 @@
+package vrcapi
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// Cache はレスポンスキャッシュのバックエンドです
+type Cache = shared.Cache
+
+// DefaultCacheTTLs はリソースごとのデフォルトのキャッシュ有効期間です
+var DefaultCacheTTLs = map[string]time.Duration{
+	"users":   time.Minute,
+	"worlds":  10 * time.Minute,
+	"avatars": 10 * time.Minute,
+	"groups":  5 * time.Minute,
+}
+
+// fetch はキャッシュを考慮してリクエストを送信します。
+// 有効期限内のエントリはそのまま返し、期限切れのエントリは ETag で再検証します。
+func (c *Client) fetch(ctx context.Context, method, path string, payload []byte, prepare func(*http.Request)) (*response, error) {
+	ttl := c.cacheTTL(method, path)
+	if ttl <= 0 {
+		return c.send(ctx, method, path, payload, prepare)
+	}
+
+	key := c.cacheEntryKey(method, path)
+	entry, cached := c.cache.Get(key)
+	if cached && time.Now().Before(entry.ExpiresAt) {
+		return &response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     entry.Header,
+			Body:       entry.Body,
+		}, nil
+	}
+
+	resp, err := c.send(ctx, method, path, payload, func(req *http.Request) {
+		if prepare != nil {
+			prepare(req)
+		}
+		if cached && entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached:
+		c.cache.Set(key, &shared.CacheEntry{
+			Body:      entry.Body,
+			Header:    entry.Header,
+			ETag:      entry.ETag,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+		return &response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     entry.Header,
+			Body:       entry.Body,
+		}, nil
+	case resp.StatusCode == http.StatusOK:
+		c.cache.Set(key, &shared.CacheEntry{
+			Body:      resp.Body,
+			Header:    resp.Header,
+			ETag:      resp.Header.Get("ETag"),
+			ExpiresAt: time.Now().Add(ttl),
+		})
+	}
+	return resp, nil
+}
+
+// cacheTTL はリクエストのキャッシュ有効期間を返します。
+// キャッシュ対象はクエリを持たない "/{resource}/{id}" 形式のGETリクエストのみです。
+func (c *Client) cacheTTL(method, path string) time.Duration {
+	if c.cache == nil || method != http.MethodGet || strings.Contains(path, "?") {
+		return 0
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) != 2 || segments[1] == "" {
+		return 0
+	}
+	return c.cacheTTLs[segments[0]]
+}
+
+// InvalidateCache は指定されたパスとその親リソースのキャッシュを破棄します。
+// 例えば "/groups/grp_xxx/leave" を指定すると "/groups/grp_xxx" のキャッシュも破棄されます。
+func (c *Client) InvalidateCache(path string) {
+	if c.cache == nil {
+		return
+	}
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for n := len(segments); n >= 2; n-- {
+		c.cache.Delete(c.cacheEntryKey(http.MethodGet, "/"+strings.Join(segments[:n], "/")))
+	}
+}
+
+// cacheKey はメソッドとパスからリクエストを識別するキーを作成します
+func cacheKey(method, path string) string {
+	return method + " " + path
+}
+
+// cacheEntryKey はキャッシュに保存する際のキーを作成します。
+// レスポンスはログイン中のユーザーによって異なるため、同じ Cache を複数のクライアントで共有しても
+// 他のクライアントのエントリを参照しないよう、クライアントごとの名前空間を付けます。
+func (c *Client) cacheEntryKey(method, path string) string {
+	return c.cacheNamespace + " " + cacheKey(method, path)
+}
+
+// newCacheNamespace はクライアントごとのキャッシュの名前空間を作成します。
+// 外部のキャッシュを複数のプロセスで共有しても衝突しないようランダムな値を使用します。
+func newCacheNamespace() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// LRUCache はエントリ数で上限を設けたLRU方式のインメモリキャッシュです
+type LRUCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// lruItem は LRUCache のリスト要素です
+type lruItem struct {
+	key   string
+	entry *shared.CacheEntry
+}
+
+// NewLRUCache は最大 maxEntries 件のエントリを保持する LRUCache を作成します
+func NewLRUCache(maxEntries int) *LRUCache {
+	if maxEntries <= 0 {
+		maxEntries = 1024
+	}
+	return &LRUCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get はキーに対応するエントリを取得します
+func (l *LRUCache) Get(key string) (*shared.CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(elem)
+	return elem.Value.(*lruItem).entry, true
+}
+
+// Set はエントリを保存し、上限を超えた場合は最も古く使われたエントリを破棄します
+func (l *LRUCache) Set(key string, entry *shared.CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.entries[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(elem)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+	for l.order.Len() > l.maxEntries {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete はキーに対応するエントリを破棄します
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.entries[key]; ok {
+		l.order.Remove(elem)
+		delete(l.entries, key)
+	}
+}
+
+// Len は保持しているエントリ数を返します
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
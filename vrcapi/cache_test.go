@@ -0,0 +1,216 @@
+package vrcapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// cachingServer は ETag を付けてワールドを返し、リクエストを記録するサーバーです
+type cachingServer struct {
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	gets        int
+	notModified int
+	etag        string
+	name        string
+}
+
+func newCachingServer(t *testing.T) *cachingServer {
+	s := &cachingServer{etag: `"v1"`, name: "First"}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.Method != http.MethodGet {
+			writeJSON(w, map[string]bool{"success": true})
+			return
+		}
+		s.gets++
+		if r.Header.Get("If-None-Match") == s.etag {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", s.etag)
+		writeJSON(w, shared.World{ID: "wrld_test", Name: s.name})
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *cachingServer) counts() (gets, notModified int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gets, s.notModified
+}
+
+func (s *cachingServer) update(etag, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.etag, s.name = etag, name
+}
+
+func newCachingClient(t *testing.T, s *cachingServer, cache Cache, ttl time.Duration) *Client {
+	t.Helper()
+	c, err := NewClient(WithBaseURL(s.srv.URL), WithCache(cache), WithCacheTTL("worlds", ttl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func mustGetWorldName(t *testing.T, c *Client) string {
+	t.Helper()
+	world, err := c.GetWorld(context.Background(), "wrld_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return world.Name
+}
+
+func TestCacheTTLAndRevalidation(t *testing.T) {
+	s := newCachingServer(t)
+	c := newCachingClient(t, s, NewLRUCache(10), 50*time.Millisecond)
+
+	// 有効期限内はサーバーに問い合わせない
+	mustGetWorldName(t, c)
+	mustGetWorldName(t, c)
+	if gets, _ := s.counts(); gets != 1 {
+		t.Fatalf("server GETs within TTL = %d, want 1", gets)
+	}
+
+	// 期限切れ後は ETag で再検証し、304 ならキャッシュの内容を返す
+	time.Sleep(60 * time.Millisecond)
+	if name := mustGetWorldName(t, c); name != "First" {
+		t.Errorf("revalidated name = %q, want First", name)
+	}
+	if gets, notModified := s.counts(); gets != 2 || notModified != 1 {
+		t.Fatalf("after expiry: GETs = %d, 304s = %d, want 2 and 1", gets, notModified)
+	}
+
+	// 304 で有効期限が延長される
+	mustGetWorldName(t, c)
+	if gets, _ := s.counts(); gets != 2 {
+		t.Errorf("server GETs after revalidation = %d, want 2", gets)
+	}
+
+	// 内容が変わっていれば新しいレスポンスで置き換える
+	s.update(`"v2"`, "Second")
+	time.Sleep(60 * time.Millisecond)
+	if name := mustGetWorldName(t, c); name != "Second" {
+		t.Errorf("name after change = %q, want Second", name)
+	}
+	if name := mustGetWorldName(t, c); name != "Second" {
+		t.Errorf("cached name after change = %q, want Second", name)
+	}
+	if gets, notModified := s.counts(); gets != 3 || notModified != 1 {
+		t.Errorf("after change: GETs = %d, 304s = %d, want 3 and 1", gets, notModified)
+	}
+}
+
+func TestCacheInvalidatedByWrite(t *testing.T) {
+	s := newCachingServer(t)
+	c := newCachingClient(t, s, NewLRUCache(10), time.Hour)
+
+	mustGetWorldName(t, c)
+	// 子リソースへの更新系リクエストで親リソースのキャッシュも破棄される
+	if err := c.doRequest(context.Background(), "PUT", "/worlds/wrld_test/publish", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	mustGetWorldName(t, c)
+	if gets, _ := s.counts(); gets != 2 {
+		t.Errorf("server GETs = %d, want 2", gets)
+	}
+
+	c.InvalidateCache("/worlds/wrld_test")
+	mustGetWorldName(t, c)
+	if gets, _ := s.counts(); gets != 3 {
+		t.Errorf("server GETs after InvalidateCache = %d, want 3", gets)
+	}
+}
+
+func TestCacheNotSharedBetweenClients(t *testing.T) {
+	s := newCachingServer(t)
+	cache := NewLRUCache(10)
+	a := newCachingClient(t, s, cache, time.Hour)
+	b := newCachingClient(t, s, cache, time.Hour)
+
+	mustGetWorldName(t, a)
+	mustGetWorldName(t, b)
+	if gets, _ := s.counts(); gets != 2 {
+		t.Errorf("server GETs = %d, want 2 (one per client)", gets)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("cache entries = %d, want 2", cache.Len())
+	}
+
+	// 一方のクライアントによる破棄は他方のエントリに影響しない
+	a.InvalidateCache("/worlds/wrld_test")
+	mustGetWorldName(t, b)
+	if gets, _ := s.counts(); gets != 2 {
+		t.Errorf("server GETs after other client invalidated = %d, want 2", gets)
+	}
+}
+
+func TestCacheTTLTargets(t *testing.T) {
+	c, err := NewClient(WithCache(NewLRUCache(10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		method, path string
+		cached       bool
+	}{
+		{method: "GET", path: "/worlds/wrld_test", cached: true},
+		{method: "GET", path: "/users/usr_test", cached: true},
+		{method: "GET", path: "/worlds/wrld_test?n=1", cached: false},
+		{method: "GET", path: "/worlds/wrld_test/metadata", cached: false},
+		{method: "GET", path: "/files/file_test", cached: false},
+		{method: "PUT", path: "/worlds/wrld_test", cached: false},
+	}
+	for _, tt := range tests {
+		if got := c.cacheTTL(tt.method, tt.path) > 0; got != tt.cached {
+			t.Errorf("cacheTTL(%s %s) > 0 = %v, want %v", tt.method, tt.path, got, tt.cached)
+		}
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", &shared.CacheEntry{ETag: "a"})
+	cache.Set("b", &shared.CacheEntry{ETag: "b"})
+	// a を参照すると b が最も古く使われたエントリになる
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("a was not cached")
+	}
+	cache.Set("c", &shared.CacheEntry{ETag: "c"})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("b was not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if entry, ok := cache.Get(key); !ok || entry.ETag != key {
+			t.Errorf("Get(%q) = %v, %v, want entry", key, entry, ok)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", cache.Len())
+	}
+
+	// 既存のキーの更新では破棄されない
+	cache.Set("a", &shared.CacheEntry{ETag: "a2"})
+	if entry, _ := cache.Get("a"); entry.ETag != "a2" || cache.Len() != 2 {
+		t.Errorf("after update: entry = %v, Len() = %d", entry, cache.Len())
+	}
+
+	cache.Delete("a")
+	if _, ok := cache.Get("a"); ok || cache.Len() != 1 {
+		t.Errorf("after Delete: Len() = %d, want 1", cache.Len())
+	}
+}
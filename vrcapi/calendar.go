@@ -0,0 +1,122 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// GetCalendarEvents は参加しているグループの今後のカレンダーイベントを取得します
+func (c *Client) GetCalendarEvents(ctx context.Context, opts shared.GetCalendarEventsOptions) (*shared.CalendarEventPage, error) {
+	page, err := c.listCalendarEvents(ctx, "/calendar", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar events: %w", err)
+	}
+	return page, nil
+}
+
+// GetFeaturedCalendarEvents はおすすめのカレンダーイベントを取得します
+func (c *Client) GetFeaturedCalendarEvents(ctx context.Context, opts shared.GetCalendarEventsOptions) (*shared.CalendarEventPage, error) {
+	page, err := c.listCalendarEvents(ctx, "/calendar/featured", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get featured calendar events: %w", err)
+	}
+	return page, nil
+}
+
+// GetFollowedCalendarEvents はフォローしているカレンダーイベントを取得します
+func (c *Client) GetFollowedCalendarEvents(ctx context.Context, opts shared.GetCalendarEventsOptions) (*shared.CalendarEventPage, error) {
+	page, err := c.listCalendarEvents(ctx, "/calendar/following", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followed calendar events: %w", err)
+	}
+	return page, nil
+}
+
+// GetGroupCalendarEvents はグループのカレンダーイベントを取得します
+func (c *Client) GetGroupCalendarEvents(ctx context.Context, groupID string, opts shared.GetCalendarEventsOptions) (*shared.CalendarEventPage, error) {
+	page, err := c.listCalendarEvents(ctx, "/calendar/"+groupID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group calendar events: %w", err)
+	}
+	return page, nil
+}
+
+// listCalendarEvents はカレンダーイベントのリストを取得します
+func (c *Client) listCalendarEvents(ctx context.Context, path string, opts shared.GetCalendarEventsOptions) (*shared.CalendarEventPage, error) {
+	params := url.Values{}
+	if !opts.Date.IsZero() {
+		params.Set("date", opts.Date.UTC().Format(time.RFC3339))
+	}
+	if opts.N > 0 {
+		params.Set("n", strconv.Itoa(opts.N))
+	} else {
+		params.Set("n", "60")
+	}
+	if opts.Offset > 0 {
+		params.Set("offset", strconv.Itoa(opts.Offset))
+	}
+
+	var page shared.CalendarEventPage
+	err := c.doRequest(ctx, "GET", path+"?"+params.Encode(), nil, &page)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetCalendarEvent はグループのカレンダーイベントを取得します
+func (c *Client) GetCalendarEvent(ctx context.Context, groupID, eventID string) (*shared.CalendarEvent, error) {
+	var event shared.CalendarEvent
+	err := c.doRequest(ctx, "GET", "/calendar/"+groupID+"/"+eventID, nil, &event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar event: %w", err)
+	}
+	return &event, nil
+}
+
+// CreateCalendarEvent はグループのカレンダーイベントを作成します
+func (c *Client) CreateCalendarEvent(ctx context.Context, groupID string, req shared.CalendarEventRequest) (*shared.CalendarEvent, error) {
+	var event shared.CalendarEvent
+	err := c.doRequest(ctx, "POST", "/calendar/"+groupID+"/event", req, &event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create calendar event: %w", err)
+	}
+	return &event, nil
+}
+
+// UpdateCalendarEvent はグループのカレンダーイベントを更新します
+func (c *Client) UpdateCalendarEvent(ctx context.Context, groupID, eventID string, req shared.CalendarEventRequest) (*shared.CalendarEvent, error) {
+	var event shared.CalendarEvent
+	err := c.doRequest(ctx, "PUT", "/calendar/"+groupID+"/"+eventID+"/event", req, &event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update calendar event: %w", err)
+	}
+	return &event, nil
+}
+
+// DeleteCalendarEvent はグループのカレンダーイベントを削除します
+func (c *Client) DeleteCalendarEvent(ctx context.Context, groupID, eventID string) error {
+	err := c.doRequest(ctx, "DELETE", "/calendar/"+groupID+"/"+eventID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar event: %w", err)
+	}
+	return nil
+}
+
+// FollowCalendarEvent はカレンダーイベントをフォロー（follow が false の場合はフォロー解除）します
+func (c *Client) FollowCalendarEvent(ctx context.Context, groupID, eventID string, follow bool) (*shared.CalendarEvent, error) {
+	var event shared.CalendarEvent
+	req := struct {
+		IsFollowing bool `json:"isFollowing"`
+	}{IsFollowing: follow}
+	err := c.doRequest(ctx, "POST", "/calendar/"+groupID+"/"+eventID+"/follow", req, &event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to follow calendar event: %w", err)
+	}
+	return &event, nil
+}
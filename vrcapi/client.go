@@ -28,6 +28,16 @@ type Client struct {
 	httpClient *http.Client
 	baseURL    string
 	userAgent  string
+
+	rawResponseHandler func(resp *shared.RawResponse)
+	drift              *driftDetector
+
+	cache          shared.Cache
+	cacheTTLs      map[string]time.Duration
+	cacheNamespace string
+
+	flights *flightGroup
+	limiter *rateLimiter
 }
 
 // NewClient は新しいVRChat APIクライアントを作成します
@@ -74,6 +84,27 @@ func NewClient(opts ...shared.Option) (*Client, error) {
 		httpClient: httpClient,
 		baseURL:    config.BaseURL,
 		userAgent:  config.UserAgent,
+
+		rawResponseHandler: config.RawResponseHandler,
+		drift:              newDriftDetector(config.SchemaDriftHandler, config.SchemaDriftLogger),
+	}
+
+	c.limiter = newRateLimiter(config.RateLimit, config.RateLimitBurst)
+	if !config.DisableRequestCoalescing {
+		c.flights = newFlightGroup()
+	}
+
+	// キャッシュの設定
+	if config.Cache != nil {
+		c.cache = config.Cache
+		c.cacheNamespace = newCacheNamespace()
+		c.cacheTTLs = make(map[string]time.Duration, len(DefaultCacheTTLs)+len(config.CacheTTLs))
+		for resource, ttl := range DefaultCacheTTLs {
+			c.cacheTTLs[resource] = ttl
+		}
+		for resource, ttl := range config.CacheTTLs {
+			c.cacheTTLs[resource] = ttl
+		}
 	}
 
 	return c, nil
@@ -81,117 +112,173 @@ func NewClient(opts ...shared.Option) (*Client, error) {
 
 // doRequest はHTTPリクエストを実行し、レスポンスをデコードします
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	var reqBody io.Reader
+	return c.do(ctx, method, path, body, result, nil)
+}
+
+// doRequestWithBasicAuth はBasic認証付きでHTTPリクエストを実行します
+func (c *Client) doRequestWithBasicAuth(ctx context.Context, method, path, username, password string, body interface{}, result interface{}) error {
+	return c.do(ctx, method, path, body, result, func(req *http.Request) {
+		req.SetBasicAuth(username, password)
+	})
+}
+
+// response は読み込み済みのHTTPレスポンスです
+type response struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+// do はHTTPリクエストを実行し、レスポンスをデコードします。
+// prepare が指定された場合は送信前にリクエストへ適用されます。
+func (c *Client) do(ctx context.Context, method, path string, body interface{}, result interface{}, prepare func(*http.Request)) error {
+	var payload []byte
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
+		payload = jsonData
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+	return c.doPayload(ctx, method, path, payload, result, prepare)
+}
 
-	req.Header.Set("User-Agent", c.userAgent)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+// doPayload はエンコード済みのボディでHTTPリクエストを実行し、レスポンスをデコードします
+func (c *Client) doPayload(ctx context.Context, method, path string, payload []byte, result interface{}, prepare func(*http.Request)) error {
+	var resp *response
+	var err error
+	if c.flights != nil && method == http.MethodGet && payload == nil && prepare == nil {
+		// 同時に発行された同一のGETは1回のHTTP呼び出しにまとめ、結果は呼び出し元ごとにデコードする
+		resp, err = c.flights.do(ctx, cacheKey(method, path), func(ctx context.Context) (*response, error) {
+			return c.fetch(ctx, method, path, nil, nil)
+		})
+	} else {
+		resp, err = c.fetch(ctx, method, path, payload, prepare)
 	}
-
-	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
+	}
+
+	raw := &shared.RawResponse{
+		Method:     method,
+		Path:       path,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       resp.Body,
 	}
-	defer resp.Body.Close()
 
 	// エラーレスポンスのチェック
 	if resp.StatusCode >= 400 {
-		var apiErr struct {
-			Error struct {
-				Message    string `json:"message"`
-				StatusCode int    `json:"status_code"`
-			} `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Error.Message != "" {
-			return &shared.APIError{
-				StatusCode: resp.StatusCode,
-				Message:    apiErr.Error.Message,
-			}
-		}
-		return &shared.APIError{
-			StatusCode: resp.StatusCode,
-			Message:    resp.Status,
-		}
+		c.handleRawResponse(raw)
+		return parseAPIError(resp)
+	}
+
+	// 自身による変更で古くなったキャッシュを破棄
+	if method != http.MethodGet {
+		c.InvalidateCache(path)
 	}
 
 	// 成功レスポンスのデコード
 	if result != nil && resp.StatusCode != http.StatusNoContent {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		err := json.Unmarshal(resp.Body, result)
+		// 型の不一致でデコードに失敗した場合も差分を報告する
+		if c.drift != nil {
+			c.drift.check(method, path, resp.Body, result)
+		}
+		if err != nil {
 			return fmt.Errorf("failed to decode response: %w", err)
 		}
+		raw.Result = result
 	}
+	c.handleRawResponse(raw)
 
 	return nil
 }
 
-// doRequestWithBasicAuth はBasic認証付きでHTTPリクエストを実行します
-func (c *Client) doRequestWithBasicAuth(ctx context.Context, method, path, username, password string, body interface{}, result interface{}) error {
+// send はHTTPリクエストを送信し、レスポンスボディを読み込みます
+func (c *Client) send(ctx context.Context, method, path string, payload []byte, prepare func(*http.Request)) (*response, error) {
+	resp, err := c.open(ctx, method, path, payload, prepare)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &response{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       data,
+	}, nil
+}
+
+// open はHTTPリクエストを送信し、ボディを読み込まずにレスポンスを返します。
+// 呼び出し元はレスポンスボディを閉じる必要があります。
+func (c *Client) open(ctx context.Context, method, path string, payload []byte, prepare func(*http.Request)) (*http.Response, error) {
 	var reqBody io.Reader
-	if body != nil {
-		jsonData, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		reqBody = bytes.NewBuffer(jsonData)
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("User-Agent", c.userAgent)
-	if body != nil {
+	if payload != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	req.SetBasicAuth(username, password)
+	if prepare != nil {
+		prepare(req)
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, fmt.Errorf("failed to wait for rate limiter: %w", err)
+		}
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
-	defer resp.Body.Close()
+	return resp, nil
+}
 
-	// エラーレスポンスのチェック
-	if resp.StatusCode >= 400 {
-		var apiErr struct {
-			Error struct {
-				Message    string `json:"message"`
-				StatusCode int    `json:"status_code"`
-			} `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Error.Message != "" {
-			return &shared.APIError{
-				StatusCode: resp.StatusCode,
-				Message:    apiErr.Error.Message,
-			}
-		}
+// handleRawResponse は設定されたハンドラーに生のレスポンスを渡します
+func (c *Client) handleRawResponse(raw *shared.RawResponse) {
+	if c.rawResponseHandler != nil {
+		c.rawResponseHandler(raw)
+	}
+}
+
+// parseAPIError はエラーレスポンスを APIError に変換します
+func parseAPIError(resp *response) error {
+	var apiErr struct {
+		Error struct {
+			Message    string `json:"message"`
+			StatusCode int    `json:"status_code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(resp.Body, &apiErr); err == nil && apiErr.Error.Message != "" {
 		return &shared.APIError{
 			StatusCode: resp.StatusCode,
-			Message:    resp.Status,
+			Message:    apiErr.Error.Message,
+			RetryAfter: parseRetryAfter(resp.Header, time.Now()),
 		}
 	}
-
-	// 成功レスポンスのデコード
-	if result != nil && resp.StatusCode != http.StatusNoContent {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
+	return &shared.APIError{
+		StatusCode: resp.StatusCode,
+		Message:    resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header, time.Now()),
 	}
-
-	return nil
 }
 
 // GetAuthCookie はCookieJarから認証クッキーを取得します
@@ -0,0 +1,109 @@
+package vrcapi
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// CoalescingStats はリクエスト集約の統計情報です
+type CoalescingStats struct {
+	// Requests は集約の対象になったGETリクエストの数です
+	Requests int64
+	// Coalesced は実行中の同一リクエストに相乗りしたため、HTTP呼び出しを省略できた数です
+	Coalesced int64
+}
+
+// flightGroup は同時に発行された同一のリクエストを1回のHTTP呼び出しにまとめます
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+
+	requests  atomic.Int64
+	coalesced atomic.Int64
+}
+
+// flightCall は実行中または完了したリクエストです
+type flightCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int // 結果を待っている呼び出し元の数（flightGroup.mu で保護）
+	resp    *response
+	err     error
+}
+
+// newFlightGroup は新しい flightGroup を作成します
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// do は key に対応するリクエストが実行中であればその結果を待ち、なければ fn を実行します。
+// fn は呼び出し元ごとのキャンセルが他の待機者に影響しないよう共有のコンテキストで実行され、
+// 待機者が全員キャンセルした時点でそのコンテキストもキャンセルされます。
+// 結果のレスポンスは待機者間で共有されるため、呼び出し元はボディを変更してはいけません。
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*response, error)) (*response, error) {
+	g.requests.Add(1)
+
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if ok {
+		call.waiters++
+		g.mu.Unlock()
+		g.coalesced.Add(1)
+	} else {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &flightCall{done: make(chan struct{}), cancel: cancel, waiters: 1}
+		g.calls[key] = call
+		g.mu.Unlock()
+
+		go func() {
+			defer cancel()
+			call.resp, call.err = fn(callCtx)
+
+			g.mu.Lock()
+			g.forget(key, call)
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+
+	select {
+	case <-call.done:
+		return call.resp, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// 結果を待つ呼び出し元がいなくなったため、HTTP呼び出しとレート制限の待機を中止する。
+			// 以降の同一リクエストはキャンセル済みの呼び出しに相乗りせず、新しく実行される
+			call.cancel()
+			g.forget(key, call)
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// forget は key に登録されている呼び出しが call であれば登録を解除します（g.mu を保持して呼び出します）
+func (g *flightGroup) forget(key string, call *flightCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
+
+// stats は現在の統計情報を返します
+func (g *flightGroup) stats() CoalescingStats {
+	return CoalescingStats{
+		Requests:  g.requests.Load(),
+		Coalesced: g.coalesced.Load(),
+	}
+}
+
+// CoalescingStats はリクエスト集約の統計情報を返します。
+// 集約が無効な場合はゼロ値を返します。
+func (c *Client) CoalescingStats() CoalescingStats {
+	if c.flights == nil {
+		return CoalescingStats{}
+	}
+	return c.flights.stats()
+}
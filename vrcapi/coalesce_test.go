@@ -0,0 +1,207 @@
+package vrcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// blockingServer は release が閉じられるまで応答を保留するサーバーです
+type blockingServer struct {
+	srv     *httptest.Server
+	hits    atomic.Int64
+	release chan struct{}
+	aborted chan struct{}
+}
+
+func newBlockingServer(t *testing.T) *blockingServer {
+	s := &blockingServer{release: make(chan struct{}), aborted: make(chan struct{}, 8)}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.hits.Add(1)
+		select {
+		case <-s.release:
+			writeJSON(w, shared.World{ID: "wrld_test", Name: "Test"})
+		case <-r.Context().Done():
+			s.aborted <- struct{}{}
+		}
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// waitForRequests は集約の対象になったリクエストが n 件になるまで待ちます
+func waitForRequests(t *testing.T, c *Client, n int64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for c.CoalescingStats().Requests < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d requests (stats %+v)", n, c.CoalescingStats())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func getTestWorld(ctx context.Context, c *Client) (*shared.World, error) {
+	var world shared.World
+	if err := c.doRequest(ctx, "GET", "/worlds/wrld_test", nil, &world); err != nil {
+		return nil, err
+	}
+	return &world, nil
+}
+
+func TestCoalescingFanOut(t *testing.T) {
+	s := newBlockingServer(t)
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			world, err := getTestWorld(context.Background(), c)
+			if err == nil && world.Name != "Test" {
+				err = errors.New("unexpected world " + world.Name)
+			}
+			errs <- err
+		}()
+	}
+	waitForRequests(t, c, callers)
+	close(s.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if got := s.hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want 1", got)
+	}
+	if got, want := c.CoalescingStats(), (CoalescingStats{Requests: callers, Coalesced: callers - 1}); got != want {
+		t.Errorf("CoalescingStats() = %+v, want %+v", got, want)
+	}
+
+	// 完了後の同一リクエストは新しく実行される
+	if _, err := getTestWorld(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.hits.Load(); got != 2 {
+		t.Errorf("server hits after completion = %d, want 2", got)
+	}
+	if got, want := c.CoalescingStats(), (CoalescingStats{Requests: callers + 1, Coalesced: callers - 1}); got != want {
+		t.Errorf("CoalescingStats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCoalescingOneCallerCancels(t *testing.T) {
+	s := newBlockingServer(t)
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 先に呼び出した側（HTTP呼び出しを開始した側）がキャンセルしても、残りの待機者は結果を受け取る
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := getTestWorld(leaderCtx, c)
+		leaderErr <- err
+	}()
+	waitForRequests(t, c, 1)
+
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := getTestWorld(context.Background(), c)
+		followerErr <- err
+	}()
+	waitForRequests(t, c, 2)
+
+	cancelLeader()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled caller error = %v, want context.Canceled", err)
+	}
+	close(s.release)
+	if err := <-followerErr; err != nil {
+		t.Errorf("remaining caller error = %v, want nil", err)
+	}
+	if got := s.hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want 1", got)
+	}
+	select {
+	case <-s.aborted:
+		t.Error("request was aborted while a caller was still waiting")
+	default:
+	}
+}
+
+func TestCoalescingAllCallersCancel(t *testing.T) {
+	s := newBlockingServer(t)
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 3
+	var wg sync.WaitGroup
+	cancels := make([]context.CancelFunc, callers)
+	for i := range cancels {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancels[i] = cancel
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := getTestWorld(ctx, c); !errors.Is(err, context.Canceled) {
+				t.Errorf("caller error = %v, want context.Canceled", err)
+			}
+		}()
+		waitForRequests(t, c, int64(i+1))
+	}
+	for _, cancel := range cancels {
+		cancel()
+	}
+	wg.Wait()
+
+	// 待機者が全員いなくなると共有のHTTP呼び出しも中止される
+	select {
+	case <-s.aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shared request was not aborted after all callers cancelled")
+	}
+
+	// 中止された呼び出しには相乗りせず、新しく実行される
+	close(s.release)
+	if _, err := getTestWorld(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.hits.Load(); got != 2 {
+		t.Errorf("server hits = %d, want 2", got)
+	}
+}
+
+func TestCoalescingDisabled(t *testing.T) {
+	s := newBlockingServer(t)
+	close(s.release)
+	c, err := NewClient(WithBaseURL(s.srv.URL), WithRequestCoalescing(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getTestWorld(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.CoalescingStats(); got != (CoalescingStats{}) {
+		t.Errorf("CoalescingStats() = %+v, want zero value", got)
+	}
+}
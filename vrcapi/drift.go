@@ -0,0 +1,343 @@
+package vrcapi
+
+import (
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+var (
+	rawMessageType  = reflect.TypeOf(json.RawMessage(nil))
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	extraType       = reflect.TypeOf(map[string]json.RawMessage(nil))
+
+	// idSegmentPattern は "usr_xxxxxxxx-..." や "wrld_xxx:12345~private(...)" のようなIDのパス要素にマッチします
+	idSegmentPattern = regexp.MustCompile(`^[a-z]+_[0-9A-Za-z-]{8,}`)
+	// numberSegmentPattern はバージョン番号などの数値のパス要素にマッチします
+	numberSegmentPattern = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// driftDetector はAPIレスポンスとモデルの差分を検出し、エンドポイントごとに重複を除いて報告します
+type driftDetector struct {
+	handler func(drift shared.SchemaDrift)
+	logger  *slog.Logger
+
+	mu   sync.Mutex
+	seen map[shared.SchemaDrift]struct{}
+}
+
+// newDriftDetector はハンドラーとロガーのいずれかが設定されている場合に driftDetector を作成します
+func newDriftDetector(handler func(drift shared.SchemaDrift), logger *slog.Logger) *driftDetector {
+	if handler == nil && logger == nil {
+		return nil
+	}
+	return &driftDetector{
+		handler: handler,
+		logger:  logger,
+		seen:    make(map[shared.SchemaDrift]struct{}),
+	}
+}
+
+// check はレスポンスボディを汎用的な値としてデコードし、result の型と比較します
+func (d *driftDetector) check(method, path string, data []byte, result interface{}) {
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return
+	}
+
+	endpoint := method + " " + normalizeEndpoint(path)
+	d.compare(endpoint, "", reflect.TypeOf(result), generic)
+}
+
+// compare は値 v が型 t と一致するかを再帰的に調べます
+func (d *driftDetector) compare(endpoint, field string, t reflect.Type, v interface{}) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// null はどの型に対しても受け入れる
+	if v == nil {
+		return
+	}
+	if t == rawMessageType || t.Kind() == reflect.Interface {
+		return
+	}
+	// 独自のデコード処理を持つ型は、Extra を保持するモデルを除いて比較しない
+	if reflect.PointerTo(t).Implements(unmarshalerType) && !hasExtraField(t) {
+		return
+	}
+
+	expected := jsonKindOf(t)
+	actual := jsonKindOfValue(v)
+	if expected != actual {
+		d.report(shared.SchemaDrift{
+			Endpoint: endpoint,
+			Field:    fieldOrRoot(field),
+			Kind:     shared.SchemaDriftKindMismatch,
+			Expected: expected,
+			Actual:   actual,
+		})
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		d.compareStruct(endpoint, field, t, v.(map[string]interface{}))
+	case reflect.Slice, reflect.Array:
+		for _, elem := range v.([]interface{}) {
+			d.compare(endpoint, field+"[]", t.Elem(), elem)
+		}
+	case reflect.Map:
+		for _, elem := range v.(map[string]interface{}) {
+			d.compare(endpoint, joinField(field, "*"), t.Elem(), elem)
+		}
+	}
+}
+
+// compareStruct はオブジェクトのフィールドを構造体のフィールドと比較します
+func (d *driftDetector) compareStruct(endpoint, field string, t reflect.Type, obj map[string]interface{}) {
+	matched := make(map[string]bool, len(obj))
+	for _, f := range jsonStructFields(t) {
+		key, ok := lookupKey(obj, f.name)
+		if !ok {
+			if !f.omitempty {
+				d.report(shared.SchemaDrift{
+					Endpoint: endpoint,
+					Field:    joinField(field, f.name),
+					Kind:     shared.SchemaDriftMissingField,
+					Expected: jsonKindOf(f.typ),
+				})
+			}
+			continue
+		}
+		matched[key] = true
+		d.compare(endpoint, joinField(field, f.name), f.typ, obj[key])
+	}
+
+	for key, value := range obj {
+		if matched[key] {
+			continue
+		}
+		d.report(shared.SchemaDrift{
+			Endpoint: endpoint,
+			Field:    joinField(field, key),
+			Kind:     shared.SchemaDriftNewField,
+			Actual:   jsonKindOfValue(value),
+		})
+	}
+}
+
+// report は未報告の差分をハンドラーとロガーに渡します
+func (d *driftDetector) report(drift shared.SchemaDrift) {
+	d.mu.Lock()
+	if _, ok := d.seen[drift]; ok {
+		d.mu.Unlock()
+		return
+	}
+	d.seen[drift] = struct{}{}
+	d.mu.Unlock()
+
+	if d.logger != nil {
+		d.logger.Warn("vrchat api schema drift",
+			"endpoint", drift.Endpoint,
+			"field", drift.Field,
+			"kind", string(drift.Kind),
+			"expected", drift.Expected,
+			"actual", drift.Actual,
+		)
+	}
+	if d.handler != nil {
+		d.handler(drift)
+	}
+}
+
+// normalizeEndpoint はパスからクエリを除き、IDや数値の要素をプレースホルダーに置き換えます
+func normalizeEndpoint(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		switch {
+		case idSegmentPattern.MatchString(seg):
+			segments[i] = "{id}"
+		case numberSegmentPattern.MatchString(seg):
+			segments[i] = "{n}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// hasExtraField は型が未知のフィールドを保持する Extra フィールドを持つかを判定します
+func hasExtraField(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	f, ok := t.FieldByName("Extra")
+	return ok && f.Type == extraType
+}
+
+// jsonField はJSONのフィールドとして扱われる構造体フィールドです
+type jsonField struct {
+	name      string
+	omitempty bool
+	typ       reflect.Type
+}
+
+// jsonStructFields は構造体型のJSONフィールドを返します。
+// encoding/json と同様に、タグで名前を付けていない埋め込み構造体のフィールドは親の階層に展開し、
+// 同じ名前のフィールドは浅い階層のものを優先します。
+func jsonStructFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	seen := make(map[string]bool)
+	type embed struct {
+		typ     reflect.Type
+		pointer bool
+	}
+	var embedded []embed
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, omitempty, skip := jsonFieldName(f)
+		if skip {
+			continue
+		}
+		if f.Anonymous && !hasJSONName(f) {
+			ft := f.Type
+			pointer := ft.Kind() == reflect.Pointer
+			if pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, embed{typ: ft, pointer: pointer})
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		seen[name] = true
+		fields = append(fields, jsonField{name: name, omitempty: omitempty, typ: f.Type})
+	}
+
+	for _, e := range embedded {
+		for _, f := range jsonStructFields(e.typ) {
+			if seen[f.name] {
+				continue
+			}
+			seen[f.name] = true
+			// nil の埋め込みポインタのフィールドは出力されないため省略可能として扱う
+			f.omitempty = f.omitempty || e.pointer
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// hasJSONName は構造体フィールドのタグでJSON名が指定されているかを判定します
+func hasJSONName(f reflect.StructField) bool {
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		return false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name != ""
+}
+
+// jsonFieldName は構造体フィールドのJSON名と omitempty の有無を返します
+func jsonFieldName(f reflect.StructField) (name string, omitempty, skip bool) {
+	name = f.Name
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		return name, false, false
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+	n, opts, _ := strings.Cut(tag, ",")
+	if n != "" {
+		name = n
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+// lookupKey は encoding/json と同様に、完全一致を優先して大文字小文字を無視したキーを探します
+func lookupKey(obj map[string]interface{}, name string) (string, bool) {
+	if _, ok := obj[name]; ok {
+		return name, true
+	}
+	for key := range obj {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// jsonKindOf はGoの型に対応するJSONの型名を返します
+func jsonKindOf(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == rawMessageType {
+		return "any"
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "any"
+	}
+}
+
+// jsonKindOfValue はデコード済みの汎用的な値のJSONの型名を返します
+func jsonKindOfValue(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	default:
+		return "any"
+	}
+}
+
+// joinField はフィールドのパスを連結します
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// fieldOrRoot はルートの値を表す空のパスを "$" に置き換えます
+func fieldOrRoot(field string) string {
+	if field == "" {
+		return "$"
+	}
+	return field
+}
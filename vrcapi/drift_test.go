@@ -0,0 +1,152 @@
+package vrcapi
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+type driftInner struct {
+	Name string `json:"name"`
+	Size int    `json:"size,omitempty"`
+}
+
+type driftEmbedded struct {
+	URL    string `json:"url"`
+	Status string `json:"status"`
+}
+
+type driftModel struct {
+	ID       string          `json:"id"`
+	Count    int             `json:"count"`
+	Note     string          `json:"note,omitempty"`
+	Inner    driftInner      `json:"inner"`
+	Items    []driftInner    `json:"items"`
+	Ignored  string          `json:"-"`
+	Embedded *driftWithEmbed `json:"embedded,omitempty"`
+}
+
+type driftWithEmbed struct {
+	driftEmbedded
+	// 親の階層のフィールドは埋め込み構造体の同名のフィールドより優先される
+	Status int    `json:"status"`
+	Extra2 string `json:"extra2"`
+}
+
+type driftWithPointerEmbed struct {
+	*driftEmbedded
+	ID string `json:"id"`
+}
+
+func TestDriftDetector(t *testing.T) {
+	tests := []struct {
+		name   string
+		result interface{}
+		body   string
+		want   []string
+	}{
+		{
+			name:   "matches",
+			result: &driftModel{},
+			body:   `{"id":"a","count":1,"inner":{"name":"x"},"items":[{"name":"y","size":2}]}`,
+			want:   nil,
+		},
+		{
+			name:   "missing field",
+			result: &driftModel{},
+			body:   `{"id":"a","inner":{},"items":[]}`,
+			want:   []string{"missing count number", "missing inner.name string"},
+		},
+		{
+			name:   "new field",
+			result: &driftModel{},
+			body:   `{"id":"a","count":1,"inner":{"name":"x","color":"red"},"items":[],"flag":true}`,
+			want:   []string{"new flag boolean", "new inner.color string"},
+		},
+		{
+			name:   "kind changed",
+			result: &driftModel{},
+			body:   `{"id":1,"count":"1","inner":{"name":"x"},"items":[{"name":false}]}`,
+			want:   []string{"kind count number string", "kind id string number", "kind items[].name string boolean"},
+		},
+		{
+			name:   "null is accepted",
+			result: &driftModel{},
+			body:   `{"id":null,"count":null,"inner":null,"items":null}`,
+			want:   nil,
+		},
+		{
+			name:   "embedded struct is flattened",
+			result: &driftModel{},
+			body:   `{"id":"a","count":1,"inner":{"name":"x"},"items":[],"embedded":{"url":"u","status":1,"extra2":"e"}}`,
+			want:   nil,
+		},
+		{
+			name:   "embedded struct missing and kind changed",
+			result: &driftModel{},
+			body:   `{"id":"a","count":1,"inner":{"name":"x"},"items":[],"embedded":{"status":"ok","extra2":"e"}}`,
+			want:   []string{"kind embedded.status number string", "missing embedded.url string"},
+		},
+		{
+			name:   "embedded pointer fields are optional",
+			result: &driftWithPointerEmbed{},
+			body:   `{"id":"a"}`,
+			want:   nil,
+		},
+		{
+			name:   "file signature",
+			result: &shared.FileVersion{},
+			body: `{"version":1,"status":"complete","created_at":"2026-01-01T00:00:00Z",
+				"signature":{"url":"u","urlObject":"o","md5":"m","sizeInMb":1,"status":"complete","category":"simple",
+				"fileName":"f","sizeInBytes":1,"uploadId":"","signature":"s","algorithm":"blake2"}}`,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			d := newDriftDetector(func(drift shared.SchemaDrift) {
+				got = append(got, strings.Join(strings.Fields(string(drift.Kind)+" "+drift.Field+" "+drift.Expected+" "+drift.Actual), " "))
+			}, nil)
+			d.check("GET", "/test", []byte(tt.body), tt.result)
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("drift = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDriftDetectorReportsOnce(t *testing.T) {
+	var count int
+	d := newDriftDetector(func(shared.SchemaDrift) { count++ }, nil)
+	for i := 0; i < 3; i++ {
+		d.check("GET", "/users/usr_12345678-aaaa", []byte(`{"id":"a","count":1,"inner":{"name":"x"},"items":[],"new":1}`), &driftModel{})
+		d.check("GET", "/users/usr_87654321-bbbb", []byte(`{"id":"a","count":1,"inner":{"name":"x"},"items":[],"new":1}`), &driftModel{})
+	}
+	if count != 1 {
+		t.Errorf("reported %d times, want 1", count)
+	}
+}
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/auth/user", want: "/auth/user"},
+		{path: "/users/usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469", want: "/users/{id}"},
+		{path: "/worlds/wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd?n=10", want: "/worlds/{id}"},
+		{path: "/instances/wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~private(usr_c1644b5b)", want: "/instances/{id}"},
+		{path: "/file/file_0123456789abcdef/3/signature/status", want: "/file/{id}/{n}/signature/status"},
+		{path: "/groups/grp_abcdefgh-1234/members/usr_abcdefgh-1234", want: "/groups/{id}/members/{id}"},
+		{path: "/users/tupper", want: "/users/tupper"},
+	}
+	for _, tt := range tests {
+		if got := normalizeEndpoint(tt.path); got != tt.want {
+			t.Errorf("normalizeEndpoint(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
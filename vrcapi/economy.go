@@ -0,0 +1,114 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// GetCurrentSubscriptions は現在のユーザーのサブスクリプションのリストを取得します
+func (c *Client) GetCurrentSubscriptions(ctx context.Context) ([]shared.UserSubscription, error) {
+	var subscriptions []shared.UserSubscription
+	err := c.doRequest(ctx, "GET", "/auth/user/subscription", nil, &subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// HasActiveSubscription は現在のユーザーに有効なサブスクリプションがあるかを確認します
+func (c *Client) HasActiveSubscription(ctx context.Context) (bool, error) {
+	subscriptions, err := c.GetCurrentSubscriptions(ctx)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	for _, s := range subscriptions {
+		if s.IsActiveAt(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsUserSupporter は指定されたユーザーが VRC+ サポーターであるかをタグから確認します
+func (c *Client) IsUserSupporter(ctx context.Context, userID string) (bool, error) {
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return shared.IsSupporter(user.Tags), nil
+}
+
+// GetSubscriptions は購入可能なサブスクリプション商品のリストを取得します
+func (c *Client) GetSubscriptions(ctx context.Context) ([]shared.Subscription, error) {
+	var subscriptions []shared.Subscription
+	err := c.doRequest(ctx, "GET", "/subscriptions", nil, &subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// GetLicenseGroup は指定されたライセンスグループを取得します
+func (c *Client) GetLicenseGroup(ctx context.Context, licenseGroupID string) (*shared.LicenseGroup, error) {
+	var group shared.LicenseGroup
+	err := c.doRequest(ctx, "GET", "/licenseGroups/"+licenseGroupID, nil, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get license group: %w", err)
+	}
+	return &group, nil
+}
+
+// GetProductListing は指定された商品を取得します
+func (c *Client) GetProductListing(ctx context.Context, listingID string) (*shared.ProductListing, error) {
+	var listing shared.ProductListing
+	err := c.doRequest(ctx, "GET", "/listing/"+listingID, nil, &listing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product listing: %w", err)
+	}
+	return &listing, nil
+}
+
+// GetUserProductListings は指定されたユーザーが販売している商品のリストを取得します
+func (c *Client) GetUserProductListings(ctx context.Context, userID string, n, offset int) ([]shared.ProductListing, error) {
+	var listings []shared.ProductListing
+	path := fmt.Sprintf("/user/%s/listings?n=%d&offset=%d", userID, n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &listings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user product listings: %w", err)
+	}
+	return listings, nil
+}
+
+// GetBalance は指定されたユーザーのトークン残高を取得します
+func (c *Client) GetBalance(ctx context.Context, userID string) (*shared.Balance, error) {
+	var balance shared.Balance
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/user/%s/balance", userID), nil, &balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return &balance, nil
+}
+
+// GetStoreTransactions は現在のユーザーのストアでの購入履歴を取得します
+func (c *Client) GetStoreTransactions(ctx context.Context) ([]shared.Transaction, error) {
+	var transactions []shared.Transaction
+	err := c.doRequest(ctx, "GET", "/Steam/transactions", nil, &transactions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+// GetStoreTransaction は指定された購入履歴を取得します
+func (c *Client) GetStoreTransaction(ctx context.Context, transactionID string) (*shared.Transaction, error) {
+	var transaction shared.Transaction
+	err := c.doRequest(ctx, "GET", "/Steam/transactions/"+transactionID, nil, &transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store transaction: %w", err)
+	}
+	return &transaction, nil
+}
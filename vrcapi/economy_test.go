@@ -0,0 +1,160 @@
+package vrcapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func newEconomyTestClient(t *testing.T, routes map[string]any) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"error": map[string]any{"message": "not found", "status_code": 404}})
+			return
+		}
+		writeJSON(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestHasActiveSubscription(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name          string
+		subscriptions []shared.UserSubscription
+		want          bool
+	}{
+		{name: "none", subscriptions: []shared.UserSubscription{}, want: false},
+		{name: "active", subscriptions: []shared.UserSubscription{{ID: "a", Active: true, Expires: future}}, want: true},
+		{name: "expired", subscriptions: []shared.UserSubscription{{ID: "a", Active: true, Expires: past}}, want: false},
+		{name: "unknown expiry", subscriptions: []shared.UserSubscription{{ID: "a", Active: true}}, want: false},
+		{
+			name: "one of many",
+			subscriptions: []shared.UserSubscription{
+				{ID: "a", Active: false, Expires: future},
+				{ID: "b", Active: true, Expires: past},
+				{ID: "c", Active: true, Expires: future},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newEconomyTestClient(t, map[string]any{"/auth/user/subscription": tt.subscriptions})
+			got, err := c.HasActiveSubscription(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("HasActiveSubscription() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUserSupporter(t *testing.T) {
+	c := newEconomyTestClient(t, map[string]any{
+		"/users/usr_plus": shared.User{ID: "usr_plus", Tags: []string{"system_trust_basic", "system_supporter"}},
+		"/users/usr_free": shared.User{ID: "usr_free", Tags: []string{"system_trust_basic"}},
+	})
+	for id, want := range map[string]bool{"usr_plus": true, "usr_free": false} {
+		got, err := c.IsUserSupporter(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("IsUserSupporter(%q) = %v, want %v", id, got, want)
+		}
+	}
+	if _, err := c.IsUserSupporter(context.Background(), "usr_missing"); !shared.IsNotFoundError(err) {
+		t.Errorf("IsUserSupporter(usr_missing) error = %v, want not found", err)
+	}
+}
+
+func TestEconomyEndpoints(t *testing.T) {
+	c := newEconomyTestClient(t, map[string]any{
+		"/user/usr_seller/listings?n=10&offset=20": []map[string]any{
+			{"id": "prod_1", "sellerId": "usr_seller", "priceTokens": 100, "products": []map[string]any{{"id": "p_1"}}},
+		},
+		"/listing/prod_1":          map[string]any{"id": "prod_1", "displayName": "Listing", "hydrated": true},
+		"/user/usr_seller/balance": shared.Balance{Balance: 1200},
+		"/licenseGroups/lgrp_1": shared.LicenseGroup{
+			ID:       "lgrp_1",
+			Licenses: []shared.License{{ForID: "avtr_1", ForType: "avatar", ForAction: "wear"}},
+		},
+		"/Steam/transactions": []map[string]any{{"id": "txn_1", "status": "active"}, {"id": "txn_2", "status": "expired"}},
+		"/Steam/transactions/txn_1": map[string]any{
+			"id":           "txn_1",
+			"subscription": map[string]any{"id": "vrchatplus-monthly", "tier": 1},
+			"steam":        map[string]any{"orderId": "123"},
+		},
+	})
+	ctx := context.Background()
+
+	listings, err := c.GetUserProductListings(ctx, "usr_seller", 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) != 1 || listings[0].PriceTokens != 100 || len(listings[0].Products) != 1 {
+		t.Errorf("GetUserProductListings() = %+v", listings)
+	}
+
+	// 未知のフィールドは Extra に保持される
+	listing, err := c.GetProductListing(ctx, "prod_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listing.DisplayName != "Listing" || string(listing.Extra["hydrated"]) != "true" {
+		t.Errorf("GetProductListing() = %+v", listing)
+	}
+
+	balance, err := c.GetBalance(ctx, "usr_seller")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Balance != 1200 {
+		t.Errorf("GetBalance() = %+v", balance)
+	}
+
+	group, err := c.GetLicenseGroup(ctx, "lgrp_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(group.Licenses) != 1 || group.Licenses[0].ForID != "avtr_1" {
+		t.Errorf("GetLicenseGroup() = %+v", group)
+	}
+
+	transactions, err := c.GetStoreTransactions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 2 || transactions[1].Status != "expired" {
+		t.Errorf("GetStoreTransactions() = %+v", transactions)
+	}
+
+	transaction, err := c.GetStoreTransaction(ctx, "txn_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transaction.Subscription == nil || transaction.Subscription.Tier != 1 || transaction.Extra["steam"] == nil {
+		t.Errorf("GetStoreTransaction() = %+v", transaction)
+	}
+
+	if _, err := c.GetStoreTransaction(ctx, "txn_missing"); !shared.IsNotFoundError(err) {
+		t.Errorf("GetStoreTransaction(txn_missing) error = %v, want not found", err)
+	}
+}
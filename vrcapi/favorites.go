@@ -2,6 +2,7 @@ package vrcapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -10,12 +11,12 @@ import (
 )
 
 // AddFavorite はお気に入りに追加します
-func (c *Client) AddFavorite(ctx context.Context, favoriteType, favoriteID string, tags []string) (*shared.Favorite, error) {
+func (c *Client) AddFavorite(ctx context.Context, favoriteType shared.FavoriteType, favoriteID string, tags []string) (*shared.Favorite, error) {
 	var favorite shared.Favorite
 	req := struct {
-		Type       string   `json:"type"`
-		FavoriteID string   `json:"favoriteId"`
-		Tags       []string `json:"tags"`
+		Type       shared.FavoriteType `json:"type"`
+		FavoriteID string              `json:"favoriteId"`
+		Tags       []string            `json:"tags"`
 	}{
 		Type:       favoriteType,
 		FavoriteID: favoriteID,
@@ -38,13 +39,13 @@ func (c *Client) RemoveFavorite(ctx context.Context, favoriteID string) error {
 }
 
 // GetFavorites はお気に入りのリストを取得します
-func (c *Client) GetFavorites(ctx context.Context, n, offset int, favoriteType, tag string) ([]shared.Favorite, error) {
+func (c *Client) GetFavorites(ctx context.Context, n, offset int, favoriteType shared.FavoriteType, tag string) ([]shared.Favorite, error) {
 	var favorites []shared.Favorite
 	params := url.Values{}
 	params.Set("n", strconv.Itoa(n))
 	params.Set("offset", strconv.Itoa(offset))
 	if favoriteType != "" {
-		params.Set("type", favoriteType)
+		params.Set("type", string(favoriteType))
 	}
 	if tag != "" {
 		params.Set("tag", tag)
@@ -57,6 +58,25 @@ func (c *Client) GetFavorites(ctx context.Context, n, offset int, favoriteType,
 	return favorites, nil
 }
 
+// MoveFavorite はお気に入りを別のお気に入りグループ（tag）に移動します。
+// 削除してから追加し直すため、追加に失敗した場合は元のグループに戻します。
+func (c *Client) MoveFavorite(ctx context.Context, favorite shared.Favorite, toTag string) (*shared.Favorite, error) {
+	if err := c.RemoveFavorite(ctx, favorite.ID); err != nil {
+		return nil, err
+	}
+
+	moved, err := c.AddFavorite(ctx, favorite.Type, favorite.FavoriteID, []string{toTag})
+	if err == nil {
+		return moved, nil
+	}
+
+	if _, rollbackErr := c.AddFavorite(ctx, favorite.Type, favorite.FavoriteID, favorite.Tags); rollbackErr != nil {
+		return nil, fmt.Errorf("failed to move favorite (rollback also failed, %s is no longer a favorite): %w",
+			favorite.FavoriteID, errors.Join(err, rollbackErr))
+	}
+	return nil, fmt.Errorf("failed to move favorite (restored to %v): %w", favorite.Tags, err)
+}
+
 // GetFavoriteGroups はお気に入りグループのリストを取得します
 func (c *Client) GetFavoriteGroups(ctx context.Context, n, offset int, ownerID string) ([]shared.FavoriteGroup, error) {
 	var groups []shared.FavoriteGroup
@@ -73,3 +93,46 @@ func (c *Client) GetFavoriteGroups(ctx context.Context, n, offset int, ownerID s
 	}
 	return groups, nil
 }
+
+// GetFavoriteGroup は指定されたお気に入りグループを取得します
+func (c *Client) GetFavoriteGroup(ctx context.Context, favoriteType shared.FavoriteType, name, userID string) (*shared.FavoriteGroup, error) {
+	var group shared.FavoriteGroup
+	err := c.doRequest(ctx, "GET", favoriteGroupPath(favoriteType, name, userID), nil, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite group: %w", err)
+	}
+	return &group, nil
+}
+
+// UpdateFavoriteGroup はお気に入りグループの表示名・公開範囲・タグを更新します
+func (c *Client) UpdateFavoriteGroup(ctx context.Context, favoriteType shared.FavoriteType, name, userID string, req shared.UpdateFavoriteGroupRequest) error {
+	err := c.doRequest(ctx, "PUT", favoriteGroupPath(favoriteType, name, userID), req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update favorite group: %w", err)
+	}
+	return nil
+}
+
+// ClearFavoriteGroup はお気に入りグループ内のお気に入りをすべて削除します
+func (c *Client) ClearFavoriteGroup(ctx context.Context, favoriteType shared.FavoriteType, name, userID string) error {
+	err := c.doRequest(ctx, "DELETE", favoriteGroupPath(favoriteType, name, userID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to clear favorite group: %w", err)
+	}
+	return nil
+}
+
+// GetFavoriteLimits はお気に入りグループ数とグループあたりのお気に入り数の上限を取得します
+func (c *Client) GetFavoriteLimits(ctx context.Context) (*shared.FavoriteLimits, error) {
+	var limits shared.FavoriteLimits
+	err := c.doRequest(ctx, "GET", "/auth/user/favoritelimits", nil, &limits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite limits: %w", err)
+	}
+	return &limits, nil
+}
+
+// favoriteGroupPath はお気に入りグループのパスを返します
+func favoriteGroupPath(favoriteType shared.FavoriteType, name, userID string) string {
+	return fmt.Sprintf("/favorite/group/%s/%s/%s", favoriteType, url.PathEscape(name), userID)
+}
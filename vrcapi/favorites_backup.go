@@ -0,0 +1,224 @@
+package vrcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// favoritesPageSize はお気に入りのバックアップ・復元で1回に取得する件数です
+const favoritesPageSize = 100
+
+// ExportFavorites は現在のユーザーのお気に入りグループとその中身をバックアップします
+func (c *Client) ExportFavorites(ctx context.Context) (*shared.FavoritesBackup, error) {
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.allFavoriteGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := c.allFavorites(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byGroup := favoritesByGroup(favorites)
+	backup := &shared.FavoritesBackup{
+		Version:    shared.FavoritesBackupVersion,
+		ExportedAt: time.Now().UTC(),
+		OwnerID:    user.ID,
+		Groups:     make([]shared.FavoriteGroupBackup, 0, len(groups)),
+	}
+	for _, group := range groups {
+		ids := byGroup[favoriteGroupKey{group.Type, group.Name}]
+		if ids == nil {
+			ids = []string{}
+		}
+		backup.Groups = append(backup.Groups, shared.FavoriteGroupBackup{
+			Type:        group.Type,
+			Name:        group.Name,
+			DisplayName: group.DisplayName,
+			Visibility:  group.Visibility,
+			FavoriteIDs: ids,
+		})
+	}
+	return backup, nil
+}
+
+// RestoreFavorites はバックアップから現在のユーザーにお気に入りを復元します。
+// お気に入りグループは種類と名前（"worlds1" など）で対応付けられるため、別のアカウントにも復元できます。
+// 既に登録済みのもの、削除済み・非公開のワールドやアバターはスキップし、
+// グループの上限を超えるものや追加に失敗したものはレポートに記録して処理を続けます。
+func (c *Client) RestoreFavorites(ctx context.Context, backup *shared.FavoritesBackup, opts shared.RestoreFavoritesOptions) (*shared.FavoritesRestoreReport, error) {
+	if backup.Version < 1 || backup.Version > shared.FavoritesBackupVersion {
+		return nil, fmt.Errorf("unsupported favorites backup version %d", backup.Version)
+	}
+
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := c.GetFavoriteLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.allFavoriteGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := c.allFavorites(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingGroups := make(map[favoriteGroupKey]shared.FavoriteGroup, len(groups))
+	for _, group := range groups {
+		existingGroups[favoriteGroupKey{group.Type, group.Name}] = group
+	}
+	counts := make(map[favoriteGroupKey]int)
+	for key, ids := range favoritesByGroup(favorites) {
+		counts[key] = len(ids)
+	}
+	favorited := make(map[favoriteGroupKey]struct{}, len(favorites))
+	for _, favorite := range favorites {
+		favorited[favoriteGroupKey{favorite.Type, favorite.FavoriteID}] = struct{}{}
+	}
+
+	report := &shared.FavoritesRestoreReport{}
+	for _, group := range backup.Groups {
+		key := favoriteGroupKey{group.Type, group.Name}
+		item := func(id, reason string) shared.FavoriteRestoreItem {
+			return shared.FavoriteRestoreItem{Type: group.Type, Group: group.Name, FavoriteID: id, Reason: reason}
+		}
+
+		existing, ok := existingGroups[key]
+		if !ok {
+			for _, id := range group.FavoriteIDs {
+				report.Failed = append(report.Failed, item(id, "favorite group does not exist"))
+			}
+			continue
+		}
+
+		if opts.RestoreGroupSettings && !opts.DryRun &&
+			(existing.DisplayName != group.DisplayName || existing.Visibility != group.Visibility) {
+			err := c.UpdateFavoriteGroup(ctx, group.Type, group.Name, user.ID, shared.UpdateFavoriteGroupRequest{
+				DisplayName: group.DisplayName,
+				Visibility:  group.Visibility,
+			})
+			if err != nil {
+				return report, err
+			}
+		}
+
+		limit := limits.PerGroupFor(group.Type)
+		for _, id := range group.FavoriteIDs {
+			if _, ok := favorited[favoriteGroupKey{group.Type, id}]; ok {
+				report.Skipped = append(report.Skipped, item(id, "already favorited"))
+				continue
+			}
+			if limit > 0 && counts[key] >= limit {
+				report.Failed = append(report.Failed, item(id, fmt.Sprintf("favorite group is full (%d)", limit)))
+				continue
+			}
+			if !opts.SkipAvailabilityCheck {
+				reason, err := c.favoriteUnavailableReason(ctx, group.Type, id, user.ID)
+				if err != nil {
+					if ctx.Err() != nil {
+						return report, ctx.Err()
+					}
+					report.Failed = append(report.Failed, item(id, err.Error()))
+					continue
+				}
+				if reason != "" {
+					report.Skipped = append(report.Skipped, item(id, reason))
+					continue
+				}
+			}
+
+			if !opts.DryRun {
+				if _, err := c.AddFavorite(ctx, group.Type, id, []string{group.Name}); err != nil {
+					if ctx.Err() != nil {
+						return report, ctx.Err()
+					}
+					report.Failed = append(report.Failed, item(id, err.Error()))
+					continue
+				}
+			}
+			favorited[favoriteGroupKey{group.Type, id}] = struct{}{}
+			counts[key]++
+			report.Restored++
+		}
+	}
+	return report, nil
+}
+
+// favoriteUnavailableReason はワールド・アバターを追加できない理由（削除済み・非公開）を返します。
+// 追加できる場合は空文字列を返します。
+func (c *Client) favoriteUnavailableReason(ctx context.Context, favoriteType shared.FavoriteType, id, userID string) (string, error) {
+	var releaseStatus, authorID string
+	var err error
+	switch favoriteType {
+	case shared.FavoriteTypeWorld:
+		var world *shared.World
+		if world, err = c.GetWorld(ctx, id); err == nil {
+			releaseStatus, authorID = world.ReleaseStatus, world.AuthorID
+		}
+	case shared.FavoriteTypeAvatar:
+		var avatar *shared.Avatar
+		if avatar, err = c.GetAvatar(ctx, id); err == nil {
+			releaseStatus, authorID = avatar.ReleaseStatus, avatar.AuthorID
+		}
+	default:
+		return "", nil
+	}
+
+	var apiErr *shared.APIError
+	switch {
+	case shared.IsNotFoundError(err):
+		return "deleted", nil
+	case errors.As(err, &apiErr) && apiErr.StatusCode == 403:
+		return "private", nil
+	case err != nil:
+		return "", err
+	case releaseStatus == "private" && authorID != userID:
+		return "private", nil
+	}
+	return "", nil
+}
+
+// allFavoriteGroups は現在のユーザーのお気に入りグループをすべて取得します
+func (c *Client) allFavoriteGroups(ctx context.Context) ([]shared.FavoriteGroup, error) {
+	return collect(paginate(ctx, favoritesPageSize, func(ctx context.Context, n, offset int) ([]shared.FavoriteGroup, error) {
+		return c.GetFavoriteGroups(ctx, n, offset, "")
+	}))
+}
+
+// allFavorites は現在のユーザーのお気に入りをすべて取得します
+func (c *Client) allFavorites(ctx context.Context) ([]shared.Favorite, error) {
+	return collect(paginate(ctx, favoritesPageSize, func(ctx context.Context, n, offset int) ([]shared.Favorite, error) {
+		return c.GetFavorites(ctx, n, offset, "", "")
+	}))
+}
+
+// favoriteGroupKey はお気に入りの種類と名前（またはID）の組です
+type favoriteGroupKey struct {
+	favoriteType shared.FavoriteType
+	name         string
+}
+
+// favoritesByGroup はお気に入りをグループごとのIDリストにまとめます
+func favoritesByGroup(favorites []shared.Favorite) map[favoriteGroupKey][]string {
+	byGroup := make(map[favoriteGroupKey][]string)
+	for _, favorite := range favorites {
+		for _, tag := range favorite.Tags {
+			key := favoriteGroupKey{favorite.Type, tag}
+			byGroup[key] = append(byGroup[key], favorite.FavoriteID)
+		}
+	}
+	return byGroup
+}
@@ -0,0 +1,312 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// fakeFavorites はお気に入りのバックアップ・復元に必要なAPIを模倣するサーバーです
+type fakeFavorites struct {
+	srv       *httptest.Server
+	userID    string
+	limits    shared.FavoriteLimits
+	groups    []shared.FavoriteGroup
+	favorites []shared.Favorite
+	worlds    map[string]shared.World
+	avatars   map[string]shared.Avatar
+	// forbidden は 403 を返すワールド・アバターのIDです
+	forbidden map[string]bool
+	// failAdd は追加に失敗するIDです
+	failAdd map[string]bool
+
+	mu      sync.Mutex
+	added   []string
+	updated []string
+}
+
+func newFakeFavorites(t *testing.T, f *fakeFavorites) *Client {
+	t.Helper()
+	page := func(w http.ResponseWriter, r *http.Request, n int, write func(offset, end int)) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("n"))
+		// サーバー側の上限により要求より少ない件数で区切る
+		end := min(offset+min(limit, 2), n)
+		write(offset, max(offset, end))
+	}
+	f.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		path := r.URL.Path
+		switch {
+		case r.Method == "GET" && path == "/auth/user":
+			writeJSON(w, shared.CurrentUser{ID: f.userID})
+		case r.Method == "GET" && path == "/auth/user/favoritelimits":
+			writeJSON(w, f.limits)
+		case r.Method == "GET" && path == "/favorite/groups":
+			page(w, r, len(f.groups), func(offset, end int) { writeJSON(w, f.groups[offset:end]) })
+		case r.Method == "GET" && path == "/favorites":
+			page(w, r, len(f.favorites), func(offset, end int) { writeJSON(w, f.favorites[offset:end]) })
+		case r.Method == "POST" && path == "/favorites":
+			var req struct {
+				Type       shared.FavoriteType `json:"type"`
+				FavoriteID string              `json:"favoriteId"`
+				Tags       []string            `json:"tags"`
+			}
+			data, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &req)
+			if f.failAdd[req.FavoriteID] {
+				w.WriteHeader(http.StatusBadRequest)
+				writeJSON(w, map[string]any{"error": map[string]any{"message": "cannot add", "status_code": 400}})
+				return
+			}
+			f.added = append(f.added, req.FavoriteID+"@"+strings.Join(req.Tags, ","))
+			writeJSON(w, shared.Favorite{ID: "fvrt_new", Type: req.Type, FavoriteID: req.FavoriteID, Tags: req.Tags})
+		case r.Method == "PUT" && strings.HasPrefix(path, "/favorite/group/"):
+			var req shared.UpdateFavoriteGroupRequest
+			data, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &req)
+			f.updated = append(f.updated, strings.TrimPrefix(path, "/favorite/group/")+" "+req.DisplayName+" "+string(req.Visibility))
+			writeJSON(w, map[string]any{"success": true})
+		case r.Method == "GET" && (strings.HasPrefix(path, "/worlds/") || strings.HasPrefix(path, "/avatars/")):
+			id := path[strings.LastIndex(path, "/")+1:]
+			world, isWorld := f.worlds[id]
+			avatar, isAvatar := f.avatars[id]
+			switch {
+			case f.forbidden[id]:
+				w.WriteHeader(http.StatusForbidden)
+				writeJSON(w, map[string]any{"error": map[string]any{"message": "forbidden", "status_code": 403}})
+			case isWorld:
+				writeJSON(w, world)
+			case isAvatar:
+				writeJSON(w, avatar)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				writeJSON(w, map[string]any{"error": map[string]any{"message": "not found", "status_code": 404}})
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(f.srv.Close)
+	c, err := NewClient(WithBaseURL(f.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestExportFavorites(t *testing.T) {
+	f := &fakeFavorites{
+		userID: "usr_source",
+		groups: []shared.FavoriteGroup{
+			{Type: shared.FavoriteTypeWorld, Name: "worlds1", DisplayName: "Chill", Visibility: shared.FavoriteGroupVisibilityPublic},
+			{Type: shared.FavoriteTypeWorld, Name: "worlds2", DisplayName: "Empty", Visibility: shared.FavoriteGroupVisibilityPrivate},
+			{Type: shared.FavoriteTypeAvatar, Name: "avatars1", DisplayName: "Avatars", Visibility: shared.FavoriteGroupVisibilityFriends},
+		},
+		favorites: []shared.Favorite{
+			{ID: "fvrt_1", Type: shared.FavoriteTypeWorld, FavoriteID: "wrld_a", Tags: []string{"worlds1"}},
+			{ID: "fvrt_2", Type: shared.FavoriteTypeAvatar, FavoriteID: "avtr_a", Tags: []string{"avatars1"}},
+			{ID: "fvrt_3", Type: shared.FavoriteTypeWorld, FavoriteID: "wrld_b", Tags: []string{"worlds1"}},
+		},
+	}
+	c := newFakeFavorites(t, f)
+
+	backup, err := c.ExportFavorites(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.Version != shared.FavoritesBackupVersion || backup.OwnerID != "usr_source" || backup.ExportedAt.IsZero() {
+		t.Errorf("backup header = %d %q %v", backup.Version, backup.OwnerID, backup.ExportedAt)
+	}
+	want := []shared.FavoriteGroupBackup{
+		{Type: shared.FavoriteTypeWorld, Name: "worlds1", DisplayName: "Chill", Visibility: shared.FavoriteGroupVisibilityPublic, FavoriteIDs: []string{"wrld_a", "wrld_b"}},
+		// 空のグループも設定を復元できるよう空のリストで出力する
+		{Type: shared.FavoriteTypeWorld, Name: "worlds2", DisplayName: "Empty", Visibility: shared.FavoriteGroupVisibilityPrivate, FavoriteIDs: []string{}},
+		{Type: shared.FavoriteTypeAvatar, Name: "avatars1", DisplayName: "Avatars", Visibility: shared.FavoriteGroupVisibilityFriends, FavoriteIDs: []string{"avtr_a"}},
+	}
+	if len(backup.Groups) != len(want) {
+		t.Fatalf("groups = %+v", backup.Groups)
+	}
+	for i, group := range backup.Groups {
+		w := want[i]
+		if group.Type != w.Type || group.Name != w.Name || group.DisplayName != w.DisplayName ||
+			group.Visibility != w.Visibility || !slices.Equal(group.FavoriteIDs, w.FavoriteIDs) || group.FavoriteIDs == nil {
+			t.Errorf("groups[%d] = %+v, want %+v", i, group, w)
+		}
+	}
+
+	// JSON を経由しても読み込める
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := shared.ParseFavoritesBackup(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Groups) != 3 || !parsed.ExportedAt.Equal(backup.ExportedAt) {
+		t.Errorf("parsed backup = %+v", parsed)
+	}
+}
+
+// restoreFixture は別のアカウントへの復元を想定したサーバーとバックアップを作成します
+func restoreFixture(t *testing.T) (*Client, *fakeFavorites, *shared.FavoritesBackup) {
+	f := &fakeFavorites{
+		userID: "usr_target",
+		limits: shared.FavoriteLimits{
+			DefaultMaxFavoritesPerGroup: 3,
+			MaxFavoritesPerGroup:        map[shared.FavoriteType]int{shared.FavoriteTypeAvatar: 50},
+		},
+		groups: []shared.FavoriteGroup{
+			{Type: shared.FavoriteTypeWorld, Name: "worlds1", DisplayName: "Old", Visibility: shared.FavoriteGroupVisibilityPrivate},
+			{Type: shared.FavoriteTypeAvatar, Name: "avatars1", DisplayName: "Avatars", Visibility: shared.FavoriteGroupVisibilityPrivate},
+			{Type: shared.FavoriteTypeFriend, Name: "group_0", DisplayName: "Group 1", Visibility: shared.FavoriteGroupVisibilityPrivate},
+		},
+		favorites: []shared.Favorite{
+			{ID: "fvrt_1", Type: shared.FavoriteTypeWorld, FavoriteID: "wrld_have", Tags: []string{"worlds1"}},
+		},
+		worlds: map[string]shared.World{
+			"wrld_ok":      {ID: "wrld_ok", ReleaseStatus: "public", AuthorID: "usr_other"},
+			"wrld_private": {ID: "wrld_private", ReleaseStatus: "private", AuthorID: "usr_other"},
+			"wrld_mine":    {ID: "wrld_mine", ReleaseStatus: "private", AuthorID: "usr_target"},
+			"wrld_over":    {ID: "wrld_over", ReleaseStatus: "public", AuthorID: "usr_other"},
+		},
+		avatars: map[string]shared.Avatar{
+			"avtr_ok":   {ID: "avtr_ok", ReleaseStatus: "public"},
+			"avtr_fail": {ID: "avtr_fail", ReleaseStatus: "public"},
+		},
+		forbidden: map[string]bool{"avtr_forbidden": true},
+		failAdd:   map[string]bool{"avtr_fail": true},
+	}
+	c := newFakeFavorites(t, f)
+	backup := &shared.FavoritesBackup{
+		Version: shared.FavoritesBackupVersion,
+		OwnerID: "usr_source",
+		Groups: []shared.FavoriteGroupBackup{
+			{
+				Type: shared.FavoriteTypeWorld, Name: "worlds1", DisplayName: "Chill", Visibility: shared.FavoriteGroupVisibilityFriends,
+				FavoriteIDs: []string{"wrld_have", "wrld_ok", "wrld_deleted", "wrld_private", "wrld_mine", "wrld_over"},
+			},
+			{Type: shared.FavoriteTypeWorld, Name: "worlds2", FavoriteIDs: []string{"wrld_x"}},
+			{Type: shared.FavoriteTypeAvatar, Name: "avatars1", DisplayName: "Avatars", Visibility: shared.FavoriteGroupVisibilityPrivate, FavoriteIDs: []string{"avtr_forbidden", "avtr_fail", "avtr_ok"}},
+			{Type: shared.FavoriteTypeFriend, Name: "group_0", DisplayName: "Group 1", Visibility: shared.FavoriteGroupVisibilityPrivate, FavoriteIDs: []string{"usr_friend"}},
+		},
+	}
+	return c, f, backup
+}
+
+func restoreItems(items []shared.FavoriteRestoreItem) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = item.Group + "/" + item.FavoriteID + ": " + item.Reason
+	}
+	return out
+}
+
+func TestRestoreFavoritesReport(t *testing.T) {
+	c, f, backup := restoreFixture(t)
+
+	report, err := c.RestoreFavorites(context.Background(), backup, shared.RestoreFavoritesOptions{RestoreGroupSettings: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Restored != 4 {
+		t.Errorf("Restored = %d, want 4", report.Restored)
+	}
+	wantSkipped := []string{
+		"worlds1/wrld_have: already favorited",
+		"worlds1/wrld_deleted: deleted",
+		// 他のユーザーの非公開ワールドは追加できないが、自分のものは追加できる
+		"worlds1/wrld_private: private",
+		"avatars1/avtr_forbidden: private",
+	}
+	if got := restoreItems(report.Skipped); !slices.Equal(got, wantSkipped) {
+		t.Errorf("Skipped = %q, want %q", got, wantSkipped)
+	}
+	failed := restoreItems(report.Failed)
+	wantFailedPrefixes := []string{
+		"worlds1/wrld_over: favorite group is full (3)",
+		"worlds2/wrld_x: favorite group does not exist",
+		"avatars1/avtr_fail: ",
+	}
+	if len(failed) != len(wantFailedPrefixes) {
+		t.Fatalf("Failed = %q", failed)
+	}
+	for i, prefix := range wantFailedPrefixes {
+		if !strings.HasPrefix(failed[i], prefix) {
+			t.Errorf("Failed[%d] = %q, want prefix %q", i, failed[i], prefix)
+		}
+	}
+	if !strings.Contains(failed[2], "cannot add") {
+		t.Errorf("Failed[2] = %q, want the API error", failed[2])
+	}
+
+	wantAdded := []string{"wrld_ok@worlds1", "wrld_mine@worlds1", "avtr_ok@avatars1", "usr_friend@group_0"}
+	if !slices.Equal(f.added, wantAdded) {
+		t.Errorf("added = %q, want %q", f.added, wantAdded)
+	}
+	// 設定が異なるグループだけを更新する
+	wantUpdated := []string{"world/worlds1/usr_target Chill friends"}
+	if !slices.Equal(f.updated, wantUpdated) {
+		t.Errorf("updated = %q, want %q", f.updated, wantUpdated)
+	}
+}
+
+func TestRestoreFavoritesDryRun(t *testing.T) {
+	c, f, backup := restoreFixture(t)
+
+	report, err := c.RestoreFavorites(context.Background(), backup, shared.RestoreFavoritesOptions{RestoreGroupSettings: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// DryRun では変更しないが、追加に失敗するかは分からないため成功として数える
+	if report.Restored != 5 || len(report.Skipped) != 4 || len(report.Failed) != 2 {
+		t.Errorf("report = %d restored, %q skipped, %q failed", report.Restored, restoreItems(report.Skipped), restoreItems(report.Failed))
+	}
+	if len(f.added) != 0 || len(f.updated) != 0 {
+		t.Errorf("dry run changed state: added %q, updated %q", f.added, f.updated)
+	}
+}
+
+func TestRestoreFavoritesSkipAvailabilityCheck(t *testing.T) {
+	c, f, backup := restoreFixture(t)
+	f.failAdd = nil
+
+	report, err := c.RestoreFavorites(context.Background(), backup, shared.RestoreFavoritesOptions{SkipAvailabilityCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 確認を省略すると削除済み・非公開のものも追加を試み、上限までで止まる
+	if report.Restored != 6 || len(report.Skipped) != 1 {
+		t.Errorf("report = %d restored, %q skipped, %q failed", report.Restored, restoreItems(report.Skipped), restoreItems(report.Failed))
+	}
+	if !slices.Contains(f.added, "wrld_ok@worlds1") || !slices.Contains(f.added, "wrld_deleted@worlds1") || slices.Contains(f.added, "wrld_private@worlds1") {
+		t.Errorf("added = %q", f.added)
+	}
+	if len(f.updated) != 0 {
+		t.Errorf("group settings updated without RestoreGroupSettings: %q", f.updated)
+	}
+}
+
+func TestRestoreFavoritesUnsupportedVersion(t *testing.T) {
+	c, _, backup := restoreFixture(t)
+	backup.Version = shared.FavoritesBackupVersion + 1
+	if _, err := c.RestoreFavorites(context.Background(), backup, shared.RestoreFavoritesOptions{}); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("err = %v, want unsupported version", err)
+	}
+	if _, err := shared.ParseFavoritesBackup([]byte(`{"version":0,"groups":[]}`)); err == nil {
+		t.Error("ParseFavoritesBackup accepted version 0")
+	}
+}
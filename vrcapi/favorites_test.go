@@ -0,0 +1,147 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestMoveFavorite(t *testing.T) {
+	favorite := shared.Favorite{ID: "fvrt_1", Type: shared.FavoriteTypeWorld, FavoriteID: "wrld_1", Tags: []string{"worlds1"}}
+
+	tests := []struct {
+		name        string
+		failTags    []string // 追加に失敗させるタグ
+		wantCalls   []string
+		wantErr     string
+		wantMovedTo string
+	}{
+		{
+			name:        "moved",
+			wantCalls:   []string{"DELETE fvrt_1", "POST worlds2"},
+			wantMovedTo: "worlds2",
+		},
+		{
+			// 移動先への追加に失敗した場合は元のグループに戻す
+			name:      "restored",
+			failTags:  []string{"worlds2"},
+			wantCalls: []string{"DELETE fvrt_1", "POST worlds2", "POST worlds1"},
+			wantErr:   "restored to [worlds1]",
+		},
+		{
+			name:      "rollback failed",
+			failTags:  []string{"worlds1", "worlds2"},
+			wantCalls: []string{"DELETE fvrt_1", "POST worlds2", "POST worlds1"},
+			wantErr:   "wrld_1 is no longer a favorite",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "DELETE":
+					calls = append(calls, "DELETE "+strings.TrimPrefix(r.URL.Path, "/favorites/"))
+					writeJSON(w, map[string]any{"success": map[string]any{"message": "ok", "status_code": 200}})
+				case "POST":
+					var req struct {
+						Type       string   `json:"type"`
+						FavoriteID string   `json:"favoriteId"`
+						Tags       []string `json:"tags"`
+					}
+					data, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(data, &req); err != nil {
+						t.Error(err)
+					}
+					tag := strings.Join(req.Tags, ",")
+					calls = append(calls, "POST "+tag)
+					if slices.Contains(tt.failTags, tag) {
+						w.WriteHeader(http.StatusBadRequest)
+						writeJSON(w, map[string]any{"error": map[string]any{"message": "group is full", "status_code": 400}})
+						return
+					}
+					writeJSON(w, shared.Favorite{ID: "fvrt_2", Type: shared.FavoriteType(req.Type), FavoriteID: req.FavoriteID, Tags: req.Tags})
+				}
+			}))
+			defer srv.Close()
+			c, err := NewClient(WithBaseURL(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			moved, err := c.MoveFavorite(context.Background(), favorite, "worlds2")
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("calls = %q, want %q", calls, tt.wantCalls)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "group is full") {
+					t.Errorf("err = %v, want containing %q and the cause", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if moved.FavoriteID != "wrld_1" || moved.Type != shared.FavoriteTypeWorld || !slices.Equal(moved.Tags, []string{tt.wantMovedTo}) {
+				t.Errorf("MoveFavorite() = %+v", moved)
+			}
+		})
+	}
+}
+
+func TestMoveFavoriteRemoveFails(t *testing.T) {
+	var posts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			posts++
+		}
+		w.WriteHeader(http.StatusNotFound)
+		writeJSON(w, map[string]any{"error": map[string]any{"message": "not found", "status_code": 404}})
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 削除に失敗した場合は追加しない
+	_, err = c.MoveFavorite(context.Background(), shared.Favorite{ID: "fvrt_1", FavoriteID: "wrld_1"}, "worlds2")
+	if !shared.IsNotFoundError(err) || posts != 0 {
+		t.Errorf("err = %v, posts = %d, want not found without posts", err, posts)
+	}
+}
+
+func TestFavoriteGroupRequests(t *testing.T) {
+	c, requests := newRecordingServer(t, map[string]any{"success": map[string]any{"message": "ok", "status_code": 200}})
+	ctx := context.Background()
+
+	err := c.UpdateFavoriteGroup(ctx, shared.FavoriteTypeAvatar, "avatars 1", "usr_1", shared.UpdateFavoriteGroupRequest{
+		DisplayName: "Favorites",
+		Visibility:  shared.FavoriteGroupVisibilityFriends,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ClearFavoriteGroup(ctx, shared.FavoriteTypeWorld, "worlds1", "usr_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	update := (*requests)[0]
+	// グループ名はパスの一部としてエスケープされる
+	if update.Method != "PUT" || update.Path != "/favorite/group/avatar/avatars%201/usr_1" {
+		t.Errorf("update request = %s %s", update.Method, update.Path)
+	}
+	assertBody(t, update.Body, map[string]string{"displayName": `"Favorites"`, "visibility": `"friends"`})
+
+	clearReq := (*requests)[1]
+	if clearReq.Method != "DELETE" || clearReq.Path != "/favorite/group/world/worlds1/usr_1" {
+		t.Errorf("clear request = %s %s", clearReq.Method, clearReq.Path)
+	}
+}
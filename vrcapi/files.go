@@ -2,7 +2,13 @@ package vrcapi
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"net/url"
+	"strconv"
 
 	"github.com/kqnade/vrcgo/shared"
 )
@@ -17,8 +23,35 @@ func (c *Client) GetFile(ctx context.Context, fileID string) (*shared.File, erro
 	return &file, nil
 }
 
+// GetFiles はファイルのリストを取得します
+func (c *Client) GetFiles(ctx context.Context, opts shared.GetFilesOptions) ([]shared.File, error) {
+	params := url.Values{}
+	if opts.Tag != "" {
+		params.Set("tag", opts.Tag)
+	}
+	if opts.UserID != "" {
+		params.Set("userId", opts.UserID)
+	}
+	if opts.N > 0 {
+		params.Set("n", strconv.Itoa(opts.N))
+	} else {
+		params.Set("n", "60")
+	}
+	if opts.Offset > 0 {
+		params.Set("offset", strconv.Itoa(opts.Offset))
+	}
+
+	var files []shared.File
+	path := "/files?" + params.Encode()
+	err := c.doRequest(ctx, "GET", path, nil, &files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files: %w", err)
+	}
+	return files, nil
+}
+
 // CreateFile はファイルを作成します
-func (c *Client) CreateFile(ctx context.Context, req interface{}) (*shared.File, error) {
+func (c *Client) CreateFile(ctx context.Context, req shared.CreateFileRequest) (*shared.File, error) {
 	var file shared.File
 	err := c.doRequest(ctx, "POST", "/file", req, &file)
 	if err != nil {
@@ -28,7 +61,7 @@ func (c *Client) CreateFile(ctx context.Context, req interface{}) (*shared.File,
 }
 
 // CreateFileVersion はファイルの新しいバージョンを作成します
-func (c *Client) CreateFileVersion(ctx context.Context, fileID string, req interface{}) (*shared.File, error) {
+func (c *Client) CreateFileVersion(ctx context.Context, fileID string, req shared.CreateFileVersionRequest) (*shared.File, error) {
 	var file shared.File
 	err := c.doRequest(ctx, "POST", "/file/"+fileID, req, &file)
 	if err != nil {
@@ -56,13 +89,86 @@ func (c *Client) DeleteFileVersion(ctx context.Context, fileID string, versionID
 	return nil
 }
 
+// StartFileDataUpload はファイルデータのアップロードを開始し、アップロード先の署名付きURLを取得します。
+// マルチパートアップロードでは partNumber に1から始まるパート番号を、シンプルアップロードでは 0 を指定します。
+func (c *Client) StartFileDataUpload(ctx context.Context, fileID string, versionID int, fileType shared.FileDataType, partNumber int) (*shared.FileUploadURL, error) {
+	var uploadURL shared.FileUploadURL
+	path := fmt.Sprintf("/file/%s/%d/%s/start", fileID, versionID, fileType)
+	if partNumber > 0 {
+		path += "?partNumber=" + strconv.Itoa(partNumber)
+	}
+	err := c.doRequest(ctx, "PUT", path, nil, &uploadURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start file data upload: %w", err)
+	}
+	return &uploadURL, nil
+}
+
+// GetFileDataUploadStatus はファイルデータのアップロード状況を取得します
+func (c *Client) GetFileDataUploadStatus(ctx context.Context, fileID string, versionID int, fileType shared.FileDataType) (*shared.FileVersionUploadStatus, error) {
+	var status shared.FileVersionUploadStatus
+	path := fmt.Sprintf("/file/%s/%d/%s/status", fileID, versionID, fileType)
+	err := c.doRequest(ctx, "GET", path, nil, &status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file data upload status: %w", err)
+	}
+	return &status, nil
+}
+
 // FinishFileDataUpload はファイルデータのアップロードを完了します
-func (c *Client) FinishFileDataUpload(ctx context.Context, fileID string, versionID int, req interface{}) (*shared.File, error) {
+func (c *Client) FinishFileDataUpload(ctx context.Context, fileID string, versionID int, fileType shared.FileDataType, req shared.FinishFileDataUploadRequest) (*shared.File, error) {
 	var file shared.File
-	path := fmt.Sprintf("/file/%s/%d/file/finish", fileID, versionID)
+	path := fmt.Sprintf("/file/%s/%d/%s/finish", fileID, versionID, fileType)
 	err := c.doRequest(ctx, "PUT", path, req, &file)
 	if err != nil {
 		return nil, fmt.Errorf("failed to finish file data upload: %w", err)
 	}
 	return &file, nil
 }
+
+// DownloadFileVersion はファイルバージョンの内容を w に書き込み、書き込んだバイト数を返します。
+// 書き込み後にファイル情報の MD5 と照合し、一致しない場合はエラーを返します
+// （その時点で w には既にデータが書き込まれています）。
+func (c *Client) DownloadFileVersion(ctx context.Context, fileID string, versionID int, w io.Writer) (int64, error) {
+	file, err := c.GetFile(ctx, fileID)
+	if err != nil {
+		return 0, err
+	}
+	var expected string
+	for _, version := range file.Versions {
+		if version.Version == versionID && version.File != nil {
+			expected = version.File.MD5
+		}
+	}
+
+	// 大きなファイルをメモリに読み込まないよう、ボディはそのまま w に書き込む
+	resp, err := c.open(ctx, "GET", fmt.Sprintf("/file/%s/%d", fileID, versionID), nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download file version: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		data, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("failed to download file version: %w", parseAPIError(&response{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Header:     resp.Header,
+			Body:       data,
+		}))
+	}
+
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(w, h), resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to download file version: %w", err)
+	}
+
+	if expected != "" {
+		sum := h.Sum(nil)
+		if expected != base64.StdEncoding.EncodeToString(sum) && expected != hex.EncodeToString(sum) {
+			return n, fmt.Errorf("failed to download file version: md5 mismatch (expected %s)", expected)
+		}
+	}
+	return n, nil
+}
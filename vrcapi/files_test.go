@@ -0,0 +1,78 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestDownloadFileVersion(t *testing.T) {
+	data := testUploadData(64 * 1024)
+	sum := md5.Sum(data)
+
+	tests := []struct {
+		name    string
+		md5     string
+		status  int
+		want    []byte
+		wantErr string
+	}{
+		{name: "base64 md5", md5: md5Sum(data), status: http.StatusOK, want: data},
+		{name: "hex md5", md5: hex.EncodeToString(sum[:]), status: http.StatusOK, want: data},
+		{name: "unknown md5", md5: "", status: http.StatusOK, want: data},
+		// 照合に失敗しても書き込み済みのデータはそのまま残る
+		{name: "md5 mismatch", md5: md5Sum([]byte("other")), status: http.StatusOK, want: data, wantErr: "md5 mismatch"},
+		{name: "not found", md5: md5Sum(data), status: http.StatusNotFound, want: nil, wantErr: "status 404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var userAgent string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/file/file_1":
+					writeJSON(w, shared.File{ID: "file_1", Versions: []shared.FileVersion{
+						{Version: 0},
+						{Version: 1, File: &shared.FileDescriptor{MD5: tt.md5}},
+					}})
+				case "/file/file_1/1":
+					userAgent = r.UserAgent()
+					if tt.status != http.StatusOK {
+						w.WriteHeader(tt.status)
+						writeJSON(w, map[string]any{"error": map[string]any{"message": "missing", "status_code": tt.status}})
+						return
+					}
+					_, _ = w.Write(data)
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer srv.Close()
+			c, err := NewClient(WithBaseURL(srv.URL), WithUserAgent("vrcgo-test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			n, err := c.DownloadFileVersion(context.Background(), "file_1", 1, &buf)
+			if tt.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+			}
+			if n != int64(len(tt.want)) || !bytes.Equal(buf.Bytes(), tt.want) {
+				t.Errorf("downloaded %d bytes, want %d", n, len(tt.want))
+			}
+			if userAgent != "vrcgo-test" {
+				t.Errorf("User-Agent = %q, want vrcgo-test", userAgent)
+			}
+		})
+	}
+}
@@ -91,3 +91,128 @@ func (c *Client) RejectFriendRequest(ctx context.Context, notificationID string)
 	}
 	return &notification, nil
 }
+
+// CancelFriendRequest は送信済みのフレンドリクエストを取り消します
+func (c *Client) CancelFriendRequest(ctx context.Context, userID string) error {
+	err := c.doRequest(ctx, "DELETE", "/user/"+userID+"/friendRequest", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to cancel friend request: %w", err)
+	}
+	return nil
+}
+
+// Boop はユーザーにBoopを送信します。emojiID を指定するとエモジを添えて送信します。
+func (c *Client) Boop(ctx context.Context, userID, emojiID string) error {
+	req := struct {
+		EmojiID string `json:"emojiId,omitempty"`
+	}{EmojiID: emojiID}
+	err := c.doRequest(ctx, "POST", "/users/"+userID+"/boop", req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to boop: %w", err)
+	}
+	return nil
+}
+
+// GetFriendStatuses は複数のユーザーとのフレンドステータスを一括取得します
+func (c *Client) GetFriendStatuses(ctx context.Context, userIDs []string, opts shared.BulkOptions[shared.FriendStatus]) (map[string]*shared.FriendStatus, map[string]error) {
+	return bulkFetch(ctx, userIDs, opts, c.GetFriendStatus)
+}
+
+// GetPendingFriendRequests は保留中のフレンドリクエスト（受信・送信の両方）を取得します。
+// 送信したリクエストは通知に表示名が含まれないため DisplayName が空になります。
+func (c *Client) GetPendingFriendRequests(ctx context.Context) ([]shared.FriendRequest, error) {
+	incoming, err := c.friendRequestNotifications(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	outgoing, err := c.friendRequestNotifications(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]shared.FriendRequest, 0, len(incoming)+len(outgoing))
+	for _, n := range incoming {
+		requests = append(requests, shared.FriendRequest{
+			NotificationID: n.ID,
+			UserID:         n.SenderUserID,
+			DisplayName:    n.SenderUsername,
+			Direction:      shared.FriendRequestIncoming,
+			CreatedAt:      n.CreatedAt,
+		})
+	}
+	for _, n := range outgoing {
+		requests = append(requests, shared.FriendRequest{
+			NotificationID: n.ID,
+			UserID:         n.ReceiverUserID,
+			Direction:      shared.FriendRequestOutgoing,
+			CreatedAt:      n.CreatedAt,
+		})
+	}
+	return requests, nil
+}
+
+// FindFriendRequest は指定されたユーザーから受信したフレンドリクエストの通知を探します。
+// 見つからない場合は nil を返します。
+func (c *Client) FindFriendRequest(ctx context.Context, userID string) (*shared.Notification, error) {
+	notifications, err := c.friendRequestNotifications(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if n, ok := friendRequestsByUser(notifications)[userID]; ok {
+		return &n, nil
+	}
+	return nil, nil
+}
+
+// AcceptFriendRequestFromUser は指定されたユーザーから受信したフレンドリクエストを承認します
+func (c *Client) AcceptFriendRequestFromUser(ctx context.Context, userID string) (*shared.Notification, error) {
+	n, err := c.FindFriendRequest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, fmt.Errorf("failed to accept friend request: no pending request from %s", userID)
+	}
+	return c.AcceptFriendRequest(ctx, n.ID)
+}
+
+// RejectFriendRequestFromUser は指定されたユーザーから受信したフレンドリクエストを拒否します
+func (c *Client) RejectFriendRequestFromUser(ctx context.Context, userID string) (*shared.Notification, error) {
+	n, err := c.FindFriendRequest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, fmt.Errorf("failed to reject friend request: no pending request from %s", userID)
+	}
+	return c.RejectFriendRequest(ctx, n.ID)
+}
+
+// friendRequestNotifications はフレンドリクエストの通知をすべてのページから取得します
+func (c *Client) friendRequestNotifications(ctx context.Context, sent bool) ([]shared.Notification, error) {
+	const pageSize = 100
+	return collect(paginate(ctx, pageSize, func(ctx context.Context, n, offset int) ([]shared.Notification, error) {
+		return c.GetNotifications(ctx, shared.GetNotificationsOptions{
+			Type:   "friendRequest",
+			Sent:   sent,
+			N:      n,
+			Offset: offset,
+		})
+	}))
+}
+
+// friendRequestsByUser は受信したフレンドリクエストの通知を送信者のユーザーIDで索引付けします。
+// 同じユーザーから複数の通知がある場合は最新のものを使用します。
+func friendRequestsByUser(notifications []shared.Notification) map[string]shared.Notification {
+	byUser := make(map[string]shared.Notification, len(notifications))
+	for _, n := range notifications {
+		if n.Type != "friendRequest" {
+			continue
+		}
+		if existing, ok := byUser[n.SenderUserID]; ok && existing.CreatedAt >= n.CreatedAt {
+			continue
+		}
+		byUser[n.SenderUserID] = n
+	}
+	return byUser
+}
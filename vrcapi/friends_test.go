@@ -0,0 +1,173 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// friendRequestServer は受信・送信したフレンドリクエストの通知をページ単位で返すサーバーです
+type friendRequestServer struct {
+	srv      *httptest.Server
+	incoming []shared.Notification
+	outgoing []shared.Notification
+
+	mu      sync.Mutex
+	actions []string
+}
+
+func newFriendRequestServer(t *testing.T, incoming, outgoing []shared.Notification) *friendRequestServer {
+	s := &friendRequestServer{incoming: incoming, outgoing: outgoing}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/auth/user/notifications":
+			q := r.URL.Query()
+			if q.Get("type") != "friendRequest" {
+				t.Errorf("type = %q, want friendRequest", q.Get("type"))
+			}
+			all := s.incoming
+			if q.Get("sent") == "true" {
+				all = s.outgoing
+			}
+			offset, _ := strconv.Atoi(q.Get("offset"))
+			n, _ := strconv.Atoi(q.Get("n"))
+			// サーバー側の上限により要求より少ない件数で区切る
+			end := min(offset+min(n, 40), len(all))
+			page := []shared.Notification{}
+			if offset < end {
+				page = all[offset:end]
+			}
+			writeJSON(w, page)
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/auth/user/friendRequests/"):
+			s.mu.Lock()
+			s.actions = append(s.actions, strings.TrimPrefix(r.URL.Path, "/auth/user/friendRequests/"))
+			s.mu.Unlock()
+			writeJSON(w, shared.Notification{ID: strings.Split(strings.TrimPrefix(r.URL.Path, "/auth/user/friendRequests/"), "/")[0]})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func friendRequestNotification(id, sender, receiver, createdAt string) shared.Notification {
+	return shared.Notification{
+		ID:             id,
+		Type:           "friendRequest",
+		SenderUserID:   sender,
+		SenderUsername: "name of " + sender,
+		ReceiverUserID: receiver,
+		CreatedAt:      createdAt,
+	}
+}
+
+func TestGetPendingFriendRequests(t *testing.T) {
+	var incoming []shared.Notification
+	for i := 0; i < 95; i++ {
+		incoming = append(incoming, friendRequestNotification(fmt.Sprintf("frq_in%d", i), fmt.Sprintf("usr_%d", i), "usr_me", "2026-01-01T00:00:00Z"))
+	}
+	outgoing := []shared.Notification{
+		friendRequestNotification("frq_out", "usr_me", "usr_target", "2026-01-02T00:00:00Z"),
+	}
+	s := newFriendRequestServer(t, incoming, outgoing)
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests, err := c.GetPendingFriendRequests(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 短いページで打ち切らずにすべてのページを取得する
+	if len(requests) != 96 {
+		t.Fatalf("got %d requests, want 96", len(requests))
+	}
+	first := requests[0]
+	if first.Direction != shared.FriendRequestIncoming || first.UserID != "usr_0" || first.DisplayName != "name of usr_0" {
+		t.Errorf("incoming request = %+v", first)
+	}
+	// 送信したリクエストは相手のユーザーIDを持ち、表示名は空になる
+	last := requests[95]
+	if last.Direction != shared.FriendRequestOutgoing || last.UserID != "usr_target" || last.DisplayName != "" || last.NotificationID != "frq_out" {
+		t.Errorf("outgoing request = %+v", last)
+	}
+}
+
+func TestFriendRequestFromUser(t *testing.T) {
+	incoming := []shared.Notification{
+		friendRequestNotification("frq_old", "usr_a", "usr_me", "2026-01-01T00:00:00Z"),
+		friendRequestNotification("frq_new", "usr_a", "usr_me", "2026-01-03T00:00:00Z"),
+		friendRequestNotification("frq_b", "usr_b", "usr_me", "2026-01-02T00:00:00Z"),
+	}
+	s := newFriendRequestServer(t, incoming, nil)
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// 同じユーザーから複数ある場合は最新の通知に応答する
+	if _, err := c.AcceptFriendRequestFromUser(ctx, "usr_a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.RejectFriendRequestFromUser(ctx, "usr_b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AcceptFriendRequestFromUser(ctx, "usr_missing"); err == nil || !strings.Contains(err.Error(), "no pending request") {
+		t.Errorf("AcceptFriendRequestFromUser(usr_missing) error = %v", err)
+	}
+	if got := strings.Join(s.actions, " "); got != "frq_new/accept frq_b/reject" {
+		t.Errorf("actions = %q, want %q", got, "frq_new/accept frq_b/reject")
+	}
+
+	n, err := c.FindFriendRequest(ctx, "usr_missing")
+	if err != nil || n != nil {
+		t.Errorf("FindFriendRequest(usr_missing) = %v, %v, want nil, nil", n, err)
+	}
+}
+
+func TestGetFriendStatuses(t *testing.T) {
+	var mu sync.Mutex
+	hits := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/user/"), "/friendStatus")
+		mu.Lock()
+		hits[userID]++
+		mu.Unlock()
+		switch userID {
+		case "usr_friend":
+			writeJSON(w, shared.FriendStatus{IsFriend: true})
+		case "usr_pending":
+			writeJSON(w, shared.FriendStatus{OutgoingRequest: true})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"error": map[string]any{"message": "not found", "status_code": 404}})
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{"usr_friend", "usr_pending", "usr_friend", "usr_missing"}
+	statuses, errs := c.GetFriendStatuses(context.Background(), ids, shared.BulkOptions[shared.FriendStatus]{Concurrency: 2})
+	if len(statuses) != 2 || !statuses["usr_friend"].IsFriend || !statuses["usr_pending"].OutgoingRequest {
+		t.Errorf("statuses = %v", statuses)
+	}
+	if len(errs) != 1 || !shared.IsNotFoundError(errs["usr_missing"]) {
+		t.Errorf("errs = %v", errs)
+	}
+	if hits["usr_friend"] != 1 {
+		t.Errorf("usr_friend fetched %d times, want 1", hits["usr_friend"])
+	}
+}
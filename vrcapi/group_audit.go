@@ -0,0 +1,168 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+const (
+	// defaultAuditPollInterval は監査ログ追跡のデフォルトのポーリング間隔です
+	defaultAuditPollInterval = 30 * time.Second
+	// auditPageSize は監査ログ追跡で1回に取得する件数です
+	auditPageSize = 100
+)
+
+// GetGroupAuditLogs はグループの監査ログを取得します
+func (c *Client) GetGroupAuditLogs(ctx context.Context, groupID string, opts shared.GetGroupAuditLogsOptions) (*shared.GroupAuditLogPage, error) {
+	params := url.Values{}
+	if opts.N > 0 {
+		params.Set("n", strconv.Itoa(opts.N))
+	} else {
+		params.Set("n", "60")
+	}
+	if opts.Offset > 0 {
+		params.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if !opts.StartDate.IsZero() {
+		params.Set("startDate", opts.StartDate.UTC().Format(time.RFC3339))
+	}
+	if !opts.EndDate.IsZero() {
+		params.Set("endDate", opts.EndDate.UTC().Format(time.RFC3339))
+	}
+	if len(opts.EventTypes) > 0 {
+		types := make([]string, len(opts.EventTypes))
+		for i, t := range opts.EventTypes {
+			types[i] = string(t)
+		}
+		params.Set("eventTypes", strings.Join(types, ","))
+	}
+	if len(opts.ActorIDs) > 0 {
+		params.Set("actorIds", strings.Join(opts.ActorIDs, ","))
+	}
+	if len(opts.TargetIDs) > 0 {
+		params.Set("targetIds", strings.Join(opts.TargetIDs, ","))
+	}
+
+	var page shared.GroupAuditLogPage
+	path := "/groups/" + groupID + "/auditLogs?" + params.Encode()
+	err := c.doRequest(ctx, "GET", path, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group audit logs: %w", err)
+	}
+	return &page, nil
+}
+
+// TailGroupAuditLogs はグループの監査ログを定期的に取得し、カーソル以降の新しいエントリだけを
+// 古い順に OnEntry へ渡します。ctx がキャンセルされるか、コールバックがエラーを返すまで戻りません。
+// エントリを処理するたびに OnCursor が呼ばれるため、永続化したカーソルから中断した位置の続きを再開できます。
+func (c *Client) TailGroupAuditLogs(ctx context.Context, groupID string, opts shared.TailGroupAuditLogsOptions) error {
+	if opts.OnEntry == nil {
+		return fmt.Errorf("failed to tail group audit logs: OnEntry is required")
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultAuditPollInterval
+	}
+
+	cursor := opts.Cursor
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		entries, err := c.fetchAuditLogsSince(ctx, groupID, cursor, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := opts.OnEntry(entry.entry); err != nil {
+				return err
+			}
+			cursor = advanceAuditCursor(cursor, entry.created, entry.entry.ID)
+			if opts.OnCursor != nil {
+				if err := opts.OnCursor(cursor); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// timedAuditEntry は作成日時を解析済みの監査ログエントリです
+type timedAuditEntry struct {
+	entry   shared.GroupAuditLogEntry
+	created time.Time
+}
+
+// fetchAuditLogsSince はカーソル以降の未処理のエントリを全ページ分取得し、古い順に返します
+func (c *Client) fetchAuditLogsSince(ctx context.Context, groupID string, cursor shared.GroupAuditCursor, opts shared.TailGroupAuditLogsOptions) ([]timedAuditEntry, error) {
+	var entries []timedAuditEntry
+	seen := make(map[string]struct{})
+	for offset := 0; ; offset += auditPageSize {
+		page, err := c.GetGroupAuditLogs(ctx, groupID, shared.GetGroupAuditLogsOptions{
+			StartDate:  cursor.Since,
+			EventTypes: opts.EventTypes,
+			ActorIDs:   opts.ActorIDs,
+			N:          auditPageSize,
+			Offset:     offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range page.Results {
+			created, err := entry.CreatedTime()
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse audit log time %q: %w", entry.CreatedAt, err)
+			}
+			if !isNewAuditEntry(cursor, created, entry.ID) {
+				continue
+			}
+			// ページング中に新しいエントリが追加されるとオフセットがずれて重複することがある
+			if _, ok := seen[entry.ID]; ok {
+				continue
+			}
+			seen[entry.ID] = struct{}{}
+			entries = append(entries, timedAuditEntry{entry: entry, created: created})
+		}
+
+		if !page.HasNext || len(page.Results) == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].created.Before(entries[j].created)
+	})
+	return entries, nil
+}
+
+// isNewAuditEntry はエントリがカーソル以降の未処理のものかを判定します
+func isNewAuditEntry(cursor shared.GroupAuditCursor, created time.Time, id string) bool {
+	if cursor.Since.IsZero() || created.After(cursor.Since) {
+		return true
+	}
+	return created.Equal(cursor.Since) && !slices.Contains(cursor.SeenIDs, id)
+}
+
+// advanceAuditCursor は処理したエントリでカーソルを進めます
+func advanceAuditCursor(cursor shared.GroupAuditCursor, created time.Time, id string) shared.GroupAuditCursor {
+	if created.After(cursor.Since) {
+		return shared.GroupAuditCursor{Since: created, SeenIDs: []string{id}}
+	}
+	cursor.SeenIDs = append(slices.Clone(cursor.SeenIDs), id)
+	return cursor
+}
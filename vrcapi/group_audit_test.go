@@ -0,0 +1,248 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+var auditBase = time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+
+// auditAt は基準時刻から sec 秒後に作成されたエントリを返します
+func auditAt(id string, sec int) shared.GroupAuditLogEntry {
+	return shared.GroupAuditLogEntry{
+		ID:        id,
+		CreatedAt: auditBase.Add(time.Duration(sec) * time.Second).Format(time.RFC3339),
+	}
+}
+
+// fakeAuditLog は新しい順に監査ログを返すサーバーです
+type fakeAuditLog struct {
+	mu      sync.Mutex
+	entries []shared.GroupAuditLogEntry
+	polls   int
+	// beforeRequest はリクエストごとにエントリを返す前に呼ばれます（poll は offset=0 の回数）
+	beforeRequest func(l *fakeAuditLog, poll, offset int)
+}
+
+func (l *fakeAuditLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	n, _ := strconv.Atoi(q.Get("n"))
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	var since time.Time
+	if s := q.Get("startDate"); s != "" {
+		since, _ = time.Parse(time.RFC3339, s)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if offset == 0 {
+		l.polls++
+	}
+	if l.beforeRequest != nil {
+		l.beforeRequest(l, l.polls, offset)
+	}
+
+	var matched []shared.GroupAuditLogEntry
+	for _, e := range l.entries {
+		created, _ := e.CreatedTime()
+		if !created.Before(since) {
+			matched = append(matched, e)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].CreatedAt > matched[j].CreatedAt })
+
+	page := shared.GroupAuditLogPage{TotalCount: len(matched)}
+	if offset < len(matched) {
+		page.Results = matched[offset:min(offset+n, len(matched))]
+	}
+	page.HasNext = offset+n < len(matched)
+	writeJSON(w, page)
+}
+
+// add はエントリを追加します（ロックを保持した状態で呼び出します）
+func (l *fakeAuditLog) add(entries ...shared.GroupAuditLogEntry) {
+	l.entries = append(l.entries, entries...)
+}
+
+// tailAuditLog は polls 回ポーリングするまで追跡し、受け取ったエントリのIDと最後のカーソルを返します
+func tailAuditLog(t *testing.T, log *fakeAuditLog, cursor shared.GroupAuditCursor, polls int) ([]string, shared.GroupAuditCursor) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hook := log.beforeRequest
+	log.beforeRequest = func(l *fakeAuditLog, poll, offset int) {
+		if poll > polls {
+			cancel()
+			return
+		}
+		if hook != nil {
+			hook(l, poll, offset)
+		}
+	}
+	srv := httptest.NewServer(log)
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	err = c.TailGroupAuditLogs(ctx, "grp_test", shared.TailGroupAuditLogsOptions{
+		Cursor:       cursor,
+		PollInterval: time.Millisecond,
+		OnEntry: func(entry shared.GroupAuditLogEntry) error {
+			ids = append(ids, entry.ID)
+			return nil
+		},
+		OnCursor: func(c shared.GroupAuditCursor) error {
+			cursor = c
+			return nil
+		},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("TailGroupAuditLogs() error = %v, want context.Canceled", err)
+	}
+	return ids, cursor
+}
+
+func TestTailGroupAuditLogsSameSecondAcrossPolls(t *testing.T) {
+	log := &fakeAuditLog{
+		entries: []shared.GroupAuditLogEntry{auditAt("a", 0), auditAt("b", 1)},
+		beforeRequest: func(l *fakeAuditLog, poll, offset int) {
+			// 2回目のポーリングまでに b と同じ秒のエントリと、より新しいエントリが追加される
+			if poll == 2 && offset == 0 {
+				l.add(auditAt("c", 1), auditAt("d", 2))
+			}
+		},
+	}
+
+	ids, cursor := tailAuditLog(t, log, shared.GroupAuditCursor{}, 3)
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(ids, want) {
+		t.Errorf("delivered %v, want %v", ids, want)
+	}
+	want := shared.GroupAuditCursor{Since: auditBase.Add(2 * time.Second), SeenIDs: []string{"d"}}
+	if !cursor.Since.Equal(want.Since) || !slices.Equal(cursor.SeenIDs, want.SeenIDs) {
+		t.Errorf("cursor = %+v, want %+v", cursor, want)
+	}
+}
+
+func TestTailGroupAuditLogsOffsetShift(t *testing.T) {
+	log := &fakeAuditLog{}
+	for i := 0; i < auditPageSize+50; i++ {
+		log.add(auditAt(fmt.Sprintf("e%03d", i), i))
+	}
+	log.beforeRequest = func(l *fakeAuditLog, poll, offset int) {
+		// 1回目のポーリングで2ページ目を取得する前に先頭へエントリが追加され、オフセットが1件ずれる
+		if poll == 1 && offset == auditPageSize {
+			l.add(auditAt("new", auditPageSize+50))
+		}
+	}
+
+	ids, _ := tailAuditLog(t, log, shared.GroupAuditCursor{}, 2)
+	if len(ids) != auditPageSize+51 {
+		t.Fatalf("delivered %d entries, want %d", len(ids), auditPageSize+51)
+	}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			t.Errorf("entry %s delivered twice", id)
+		}
+		seen[id] = true
+	}
+	if ids[0] != "e000" || ids[len(ids)-1] != "new" {
+		t.Errorf("delivered %s ... %s, want oldest first", ids[0], ids[len(ids)-1])
+	}
+}
+
+func TestTailGroupAuditLogsResumeFromPersistedCursor(t *testing.T) {
+	entries := []shared.GroupAuditLogEntry{auditAt("a", 0), auditAt("b", 1), auditAt("c", 1), auditAt("d", 2)}
+
+	// 1回目の追跡では b まで処理したところで中断し、カーソルを永続化する
+	var persisted []byte
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := httptest.NewServer(&fakeAuditLog{entries: entries})
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errStop := errors.New("stop")
+	var first []string
+	err = c.TailGroupAuditLogs(ctx, "grp_test", shared.TailGroupAuditLogsOptions{
+		PollInterval: time.Millisecond,
+		OnEntry: func(entry shared.GroupAuditLogEntry) error {
+			first = append(first, entry.ID)
+			return nil
+		},
+		OnCursor: func(cursor shared.GroupAuditCursor) error {
+			data, err := json.Marshal(cursor)
+			if err != nil {
+				return err
+			}
+			persisted = data
+			if slices.Contains(cursor.SeenIDs, "b") {
+				return errStop
+			}
+			return nil
+		},
+	})
+	if !errors.Is(err, errStop) {
+		t.Fatalf("TailGroupAuditLogs() error = %v, want errStop", err)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(first, want) {
+		t.Fatalf("first run delivered %v, want %v", first, want)
+	}
+
+	// 永続化したカーソルから再開すると、同じ秒の未処理の c とそれ以降だけが届く
+	var cursor shared.GroupAuditCursor
+	if err := json.Unmarshal(persisted, &cursor); err != nil {
+		t.Fatal(err)
+	}
+	ids, _ := tailAuditLog(t, &fakeAuditLog{entries: entries}, cursor, 2)
+	if want := []string{"c", "d"}; !slices.Equal(ids, want) {
+		t.Errorf("resumed run delivered %v, want %v", ids, want)
+	}
+}
+
+func TestAdvanceAuditCursor(t *testing.T) {
+	t0 := auditBase
+	t1 := auditBase.Add(time.Second)
+
+	cursor := advanceAuditCursor(shared.GroupAuditCursor{}, t0, "a")
+	cursor = advanceAuditCursor(cursor, t1, "b")
+	next := advanceAuditCursor(cursor, t1, "c")
+	if !next.Since.Equal(t1) || !slices.Equal(next.SeenIDs, []string{"b", "c"}) {
+		t.Errorf("cursor = %+v, want since %v with [b c]", next, t1)
+	}
+	// 元のカーソルの SeenIDs は変更されない
+	if !slices.Equal(cursor.SeenIDs, []string{"b"}) {
+		t.Errorf("previous cursor was modified: %+v", cursor)
+	}
+
+	if !isNewAuditEntry(next, t1.Add(time.Second), "d") {
+		t.Error("later entry is not new")
+	}
+	if isNewAuditEntry(next, t1, "c") {
+		t.Error("seen same-second entry is new")
+	}
+	if !isNewAuditEntry(next, t1, "e") {
+		t.Error("unseen same-second entry is not new")
+	}
+	if isNewAuditEntry(next, t0, "z") {
+		t.Error("older entry is new")
+	}
+}
@@ -34,6 +34,45 @@ func (c *Client) SearchGroups(ctx context.Context, query string, n, offset int)
 	return groups, nil
 }
 
+// CreateGroup はグループを作成します
+func (c *Client) CreateGroup(ctx context.Context, req shared.CreateGroupRequest) (*shared.Group, error) {
+	var group shared.Group
+	err := c.doRequest(ctx, "POST", "/groups", req, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+	return &group, nil
+}
+
+// UpdateGroup はグループ情報を更新します
+func (c *Client) UpdateGroup(ctx context.Context, groupID string, req shared.UpdateGroupRequest) (*shared.Group, error) {
+	var group shared.Group
+	err := c.doRequest(ctx, "PUT", "/groups/"+groupID, req, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	}
+	return &group, nil
+}
+
+// DeleteGroup はグループを削除します
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+	return nil
+}
+
+// GetGroupInstances はグループで現在開かれているインスタンスのリストを取得します
+func (c *Client) GetGroupInstances(ctx context.Context, groupID string) ([]shared.GroupInstance, error) {
+	var instances []shared.GroupInstance
+	err := c.doRequest(ctx, "GET", "/groups/"+groupID+"/instances", nil, &instances)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group instances: %w", err)
+	}
+	return instances, nil
+}
+
 // JoinGroup はグループに参加します
 func (c *Client) JoinGroup(ctx context.Context, groupID string) error {
 	err := c.doRequest(ctx, "POST", "/groups/"+groupID+"/join", nil, nil)
@@ -81,6 +120,105 @@ func (c *Client) UnbanGroupMember(ctx context.Context, groupID, userID string) e
 	return nil
 }
 
+// GetGroupBans はグループのBANリストを取得します
+func (c *Client) GetGroupBans(ctx context.Context, groupID string, n, offset int) ([]shared.GroupMember, error) {
+	var members []shared.GroupMember
+	path := fmt.Sprintf("/groups/%s/bans?n=%d&offset=%d", groupID, n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group bans: %w", err)
+	}
+	return members, nil
+}
+
+// KickGroupMember はグループメンバーをグループから退出させます
+func (c *Client) KickGroupMember(ctx context.Context, groupID, userID string) error {
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID+"/members/"+userID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to kick group member: %w", err)
+	}
+	return nil
+}
+
+// UpdateGroupMember はグループメンバーの公開範囲・お知らせの購読・管理者メモを更新します
+func (c *Client) UpdateGroupMember(ctx context.Context, groupID, userID string, req shared.UpdateGroupMemberRequest) (*shared.GroupMember, error) {
+	var member shared.GroupMember
+	err := c.doRequest(ctx, "PUT", "/groups/"+groupID+"/members/"+userID, req, &member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group member: %w", err)
+	}
+	return &member, nil
+}
+
+// InviteUserToGroup はユーザーをグループに招待します
+func (c *Client) InviteUserToGroup(ctx context.Context, groupID, userID string) error {
+	err := c.doRequest(ctx, "POST", "/groups/"+groupID+"/invites", map[string]string{"userId": userID}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to invite user to group: %w", err)
+	}
+	return nil
+}
+
+// GetGroupInvites はグループから送信中の招待リストを取得します
+func (c *Client) GetGroupInvites(ctx context.Context, groupID string, n, offset int) ([]shared.GroupMember, error) {
+	var members []shared.GroupMember
+	path := fmt.Sprintf("/groups/%s/invites?n=%d&offset=%d", groupID, n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group invites: %w", err)
+	}
+	return members, nil
+}
+
+// CancelGroupInvite は送信済みのグループ招待を取り消します
+func (c *Client) CancelGroupInvite(ctx context.Context, groupID, userID string) error {
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID+"/invites/"+userID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to cancel group invite: %w", err)
+	}
+	return nil
+}
+
+// GetGroupJoinRequests はグループへの保留中の参加リクエストリストを取得します
+func (c *Client) GetGroupJoinRequests(ctx context.Context, groupID string, n, offset int) ([]shared.GroupMember, error) {
+	var members []shared.GroupMember
+	path := fmt.Sprintf("/groups/%s/requests?n=%d&offset=%d", groupID, n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group join requests: %w", err)
+	}
+	return members, nil
+}
+
+// RespondGroupJoinRequest はグループへの参加リクエストに応答します。
+// block を指定して拒否した場合、そのユーザーは以降参加リクエストを送れなくなります。
+func (c *Client) RespondGroupJoinRequest(ctx context.Context, groupID, userID string, action shared.GroupJoinRequestAction, block bool) error {
+	body := map[string]interface{}{"action": action}
+	if block {
+		body["block"] = true
+	}
+	err := c.doRequest(ctx, "PUT", "/groups/"+groupID+"/requests/"+userID, body, nil)
+	if err != nil {
+		return fmt.Errorf("failed to respond to group join request: %w", err)
+	}
+	return nil
+}
+
+// AcceptGroupJoinRequest はグループへの参加リクエストを承認します
+func (c *Client) AcceptGroupJoinRequest(ctx context.Context, groupID, userID string) error {
+	return c.RespondGroupJoinRequest(ctx, groupID, userID, shared.GroupJoinRequestAccept, false)
+}
+
+// RejectGroupJoinRequest はグループへの参加リクエストを拒否します
+func (c *Client) RejectGroupJoinRequest(ctx context.Context, groupID, userID string) error {
+	return c.RespondGroupJoinRequest(ctx, groupID, userID, shared.GroupJoinRequestReject, false)
+}
+
+// BlockGroupJoinRequest はグループへの参加リクエストを拒否し、以降のリクエストをブロックします
+func (c *Client) BlockGroupJoinRequest(ctx context.Context, groupID, userID string) error {
+	return c.RespondGroupJoinRequest(ctx, groupID, userID, shared.GroupJoinRequestReject, true)
+}
+
 // GetGroupAnnouncements はグループのお知らせリストを取得します
 func (c *Client) GetGroupAnnouncements(ctx context.Context, groupID string) ([]shared.GroupAnnouncement, error) {
 	var announcements []shared.GroupAnnouncement
@@ -90,3 +228,205 @@ func (c *Client) GetGroupAnnouncements(ctx context.Context, groupID string) ([]s
 	}
 	return announcements, nil
 }
+
+// GetGroupMember はグループの特定のメンバー情報を取得します
+func (c *Client) GetGroupMember(ctx context.Context, groupID, userID string) (*shared.GroupMember, error) {
+	var member shared.GroupMember
+	err := c.doRequest(ctx, "GET", "/groups/"+groupID+"/members/"+userID, nil, &member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group member: %w", err)
+	}
+	return &member, nil
+}
+
+// GetGroupRoles はグループのロールリストを取得します
+func (c *Client) GetGroupRoles(ctx context.Context, groupID string) ([]shared.GroupRole, error) {
+	var roles []shared.GroupRole
+	err := c.doRequest(ctx, "GET", "/groups/"+groupID+"/roles", nil, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group roles: %w", err)
+	}
+	return roles, nil
+}
+
+// CreateGroupRole はグループのロールを作成します
+func (c *Client) CreateGroupRole(ctx context.Context, groupID string, req shared.CreateGroupRoleRequest) (*shared.GroupRole, error) {
+	var role shared.GroupRole
+	err := c.doRequest(ctx, "POST", "/groups/"+groupID+"/roles", req, &role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group role: %w", err)
+	}
+	return &role, nil
+}
+
+// UpdateGroupRole はグループのロールを更新し、更新後のロールリストを返します
+func (c *Client) UpdateGroupRole(ctx context.Context, groupID, roleID string, req shared.UpdateGroupRoleRequest) ([]shared.GroupRole, error) {
+	var roles []shared.GroupRole
+	err := c.doRequest(ctx, "PUT", "/groups/"+groupID+"/roles/"+roleID, req, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group role: %w", err)
+	}
+	return roles, nil
+}
+
+// DeleteGroupRole はグループのロールを削除し、削除後のロールリストを返します
+func (c *Client) DeleteGroupRole(ctx context.Context, groupID, roleID string) ([]shared.GroupRole, error) {
+	var roles []shared.GroupRole
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID+"/roles/"+roleID, nil, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete group role: %w", err)
+	}
+	return roles, nil
+}
+
+// AddRoleToGroupMember はグループメンバーにロールを付与し、メンバーのロールIDリストを返します
+func (c *Client) AddRoleToGroupMember(ctx context.Context, groupID, userID, roleID string) ([]string, error) {
+	var roleIDs []string
+	path := fmt.Sprintf("/groups/%s/members/%s/roles/%s", groupID, userID, roleID)
+	err := c.doRequest(ctx, "PUT", path, nil, &roleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add role to group member: %w", err)
+	}
+	return roleIDs, nil
+}
+
+// RemoveRoleFromGroupMember はグループメンバーからロールを外し、メンバーのロールIDリストを返します
+func (c *Client) RemoveRoleFromGroupMember(ctx context.Context, groupID, userID, roleID string) ([]string, error) {
+	var roleIDs []string
+	path := fmt.Sprintf("/groups/%s/members/%s/roles/%s", groupID, userID, roleID)
+	err := c.doRequest(ctx, "DELETE", path, nil, &roleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove role from group member: %w", err)
+	}
+	return roleIDs, nil
+}
+
+// GetGroupMemberPermissions はメンバーのロールから実効権限を計算して返します
+func (c *Client) GetGroupMemberPermissions(ctx context.Context, groupID, userID string) (shared.GroupPermissionSet, error) {
+	member, err := c.GetGroupMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := c.GetGroupRoles(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return shared.EffectiveGroupPermissions(*member, roles), nil
+}
+
+// CreateGroupAnnouncement はグループのお知らせを作成します
+func (c *Client) CreateGroupAnnouncement(ctx context.Context, groupID string, req shared.CreateGroupAnnouncementRequest) (*shared.GroupAnnouncement, error) {
+	var announcement shared.GroupAnnouncement
+	err := c.doRequest(ctx, "POST", "/groups/"+groupID+"/announcements", req, &announcement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group announcement: %w", err)
+	}
+	return &announcement, nil
+}
+
+// DeleteGroupAnnouncement はグループのお知らせを削除します
+func (c *Client) DeleteGroupAnnouncement(ctx context.Context, groupID, announcementID string) error {
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID+"/announcements/"+announcementID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete group announcement: %w", err)
+	}
+	return nil
+}
+
+// GetGroupPosts はグループの投稿リストを取得します
+func (c *Client) GetGroupPosts(ctx context.Context, groupID string, n, offset int, publicOnly bool) ([]shared.GroupPost, error) {
+	var result struct {
+		Posts []shared.GroupPost `json:"posts"`
+	}
+	path := fmt.Sprintf("/groups/%s/posts?n=%d&offset=%d&publicOnly=%t", groupID, n, offset, publicOnly)
+	err := c.doRequest(ctx, "GET", path, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group posts: %w", err)
+	}
+	return result.Posts, nil
+}
+
+// CreateGroupPost はグループに投稿します
+func (c *Client) CreateGroupPost(ctx context.Context, groupID string, req shared.GroupPostRequest) (*shared.GroupPost, error) {
+	var post shared.GroupPost
+	err := c.doRequest(ctx, "POST", "/groups/"+groupID+"/posts", req, &post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group post: %w", err)
+	}
+	return &post, nil
+}
+
+// UpdateGroupPost はグループの投稿を更新します
+func (c *Client) UpdateGroupPost(ctx context.Context, groupID, postID string, req shared.UpdateGroupPostRequest) (*shared.GroupPost, error) {
+	var post shared.GroupPost
+	err := c.doRequest(ctx, "PUT", "/groups/"+groupID+"/posts/"+postID, req, &post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group post: %w", err)
+	}
+	return &post, nil
+}
+
+// DeleteGroupPost はグループの投稿を削除します
+func (c *Client) DeleteGroupPost(ctx context.Context, groupID, postID string) error {
+	err := c.doRequest(ctx, "DELETE", "/groups/"+groupID+"/posts/"+postID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete group post: %w", err)
+	}
+	return nil
+}
+
+// GetGroupGalleries はグループのギャラリーリストを取得します
+func (c *Client) GetGroupGalleries(ctx context.Context, groupID string) ([]shared.GroupGallery, error) {
+	group, err := c.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return group.Galleries, nil
+}
+
+// GetGroupGalleryImages はグループギャラリーの画像リストを取得します。
+// approved が nil の場合は承認状態に関わらずすべての画像を取得します。
+func (c *Client) GetGroupGalleryImages(ctx context.Context, groupID, galleryID string, n, offset int, approved *bool) ([]shared.GroupGalleryImage, error) {
+	var images []shared.GroupGalleryImage
+	path := fmt.Sprintf("/groups/%s/galleries/%s?n=%d&offset=%d", groupID, galleryID, n, offset)
+	if approved != nil {
+		path += "&approved=" + strconv.FormatBool(*approved)
+	}
+	err := c.doRequest(ctx, "GET", path, nil, &images)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group gallery images: %w", err)
+	}
+	return images, nil
+}
+
+// SubmitGroupGalleryImage はアップロード済みの画像をグループギャラリーに投稿します
+func (c *Client) SubmitGroupGalleryImage(ctx context.Context, groupID, galleryID, fileID string) (*shared.GroupGalleryImage, error) {
+	var image shared.GroupGalleryImage
+	path := fmt.Sprintf("/groups/%s/galleries/%s/images", groupID, galleryID)
+	err := c.doRequest(ctx, "POST", path, map[string]string{"fileId": fileID}, &image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit group gallery image: %w", err)
+	}
+	return &image, nil
+}
+
+// ApproveGroupGalleryImage は承認待ちのグループギャラリー画像を承認します
+func (c *Client) ApproveGroupGalleryImage(ctx context.Context, groupID, galleryID, imageID string) (*shared.GroupGalleryImage, error) {
+	var image shared.GroupGalleryImage
+	path := fmt.Sprintf("/groups/%s/galleries/%s/images/%s", groupID, galleryID, imageID)
+	err := c.doRequest(ctx, "PUT", path, map[string]bool{"approved": true}, &image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve group gallery image: %w", err)
+	}
+	return &image, nil
+}
+
+// DeleteGroupGalleryImage はグループギャラリーの画像を削除します（承認待ちの画像の却下にも使用します）
+func (c *Client) DeleteGroupGalleryImage(ctx context.Context, groupID, galleryID, imageID string) error {
+	path := fmt.Sprintf("/groups/%s/galleries/%s/images/%s", groupID, galleryID, imageID)
+	err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete group gallery image: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// recordedRequest はテストサーバーが受け取ったリクエストです
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]json.RawMessage
+}
+
+// newRecordingServer はリクエストを記録し、response を返すサーバーを作成します
+func newRecordingServer(t *testing.T, response any) (*Client, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{Method: r.Method, Path: r.URL.RequestURI()}
+		data, _ := io.ReadAll(r.Body)
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &rec.Body); err != nil {
+				t.Errorf("request body is not a JSON object: %s", data)
+			}
+		}
+		requests = append(requests, rec)
+		writeJSON(w, response)
+	}))
+	t.Cleanup(srv.Close)
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, &requests
+}
+
+// bodyKeys はリクエストボディに含まれるキーを JSON 文字列として返します
+func bodyKeys(body map[string]json.RawMessage) map[string]string {
+	keys := make(map[string]string, len(body))
+	for k, v := range body {
+		keys[k] = string(v)
+	}
+	return keys
+}
+
+func assertBody(t *testing.T, got map[string]json.RawMessage, want map[string]string) {
+	t.Helper()
+	keys := bodyKeys(got)
+	if len(keys) != len(want) {
+		t.Errorf("body = %v, want %v", keys, want)
+		return
+	}
+	for k, v := range want {
+		if keys[k] != v {
+			t.Errorf("body[%q] = %s, want %s", k, keys[k], v)
+		}
+	}
+}
+
+func TestCreateGroup(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.Group{ID: "grp_new", Name: "New", ShortCode: "NEW"})
+	group, err := c.CreateGroup(context.Background(), shared.CreateGroupRequest{
+		Name:         "New",
+		ShortCode:    "NEW",
+		Privacy:      shared.GroupPrivacyPrivate,
+		RoleTemplate: "managedInvite",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.ID != "grp_new" {
+		t.Errorf("CreateGroup() = %+v", group)
+	}
+	req := (*requests)[0]
+	if req.Method != "POST" || req.Path != "/groups" {
+		t.Errorf("request = %s %s, want POST /groups", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{
+		"name":         `"New"`,
+		"shortCode":    `"NEW"`,
+		"privacy":      `"private"`,
+		"roleTemplate": `"managedInvite"`,
+	})
+}
+
+func TestUpdateGroup(t *testing.T) {
+	name := "Renamed"
+	empty := ""
+	tags := []string{"language_jpn"}
+	noLinks := []string{}
+
+	tests := []struct {
+		name string
+		req  shared.UpdateGroupRequest
+		want map[string]string
+	}{
+		{
+			name: "nothing",
+			req:  shared.UpdateGroupRequest{},
+			want: map[string]string{},
+		},
+		{
+			name: "set fields",
+			req:  shared.UpdateGroupRequest{Name: &name, Tags: &tags, JoinState: shared.GroupJoinStateRequest},
+			want: map[string]string{"name": `"Renamed"`, "tags": `["language_jpn"]`, "joinState": `"request"`},
+		},
+		{
+			// 空の値を指すポインタでフィールドを消去できる
+			name: "clear fields",
+			req:  shared.UpdateGroupRequest{Description: &empty, Links: &noLinks},
+			want: map[string]string{"description": `""`, "links": `[]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newRecordingServer(t, shared.Group{ID: "grp_1"})
+			if _, err := c.UpdateGroup(context.Background(), "grp_1", tt.req); err != nil {
+				t.Fatal(err)
+			}
+			req := (*requests)[0]
+			if req.Method != "PUT" || req.Path != "/groups/grp_1" {
+				t.Errorf("request = %s %s, want PUT /groups/grp_1", req.Method, req.Path)
+			}
+			assertBody(t, req.Body, tt.want)
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	c, requests := newRecordingServer(t, map[string]bool{"success": true})
+	if err := c.DeleteGroup(context.Background(), "grp_1"); err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != "DELETE" || req.Path != "/groups/grp_1" || req.Body != nil {
+		t.Errorf("request = %+v, want DELETE /groups/grp_1 without body", req)
+	}
+}
+
+func TestCreateGroupPost(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.GroupPost{ID: "post_1"})
+	_, err := c.CreateGroupPost(context.Background(), "grp_1", shared.GroupPostRequest{
+		Title:      "Hello",
+		Text:       "World",
+		Visibility: shared.GroupPostVisibilityGroup,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != "POST" || req.Path != "/groups/grp_1/posts" {
+		t.Errorf("request = %s %s, want POST /groups/grp_1/posts", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{
+		"title":            `"Hello"`,
+		"text":             `"World"`,
+		"sendNotification": `false`,
+		"visibility":       `"group"`,
+	})
+}
+
+func TestUpdateGroupPost(t *testing.T) {
+	text := "Edited"
+	notify := false
+	noRoles := []string{}
+
+	tests := []struct {
+		name string
+		req  shared.UpdateGroupPostRequest
+		want map[string]string
+	}{
+		{
+			// 本文だけの更新で通知や公開範囲を送らない
+			name: "text only",
+			req:  shared.UpdateGroupPostRequest{Text: &text},
+			want: map[string]string{"text": `"Edited"`},
+		},
+		{
+			name: "explicit false and empty roles",
+			req:  shared.UpdateGroupPostRequest{SendNotification: &notify, RoleIDs: &noRoles, Visibility: shared.GroupPostVisibilityPublic},
+			want: map[string]string{"sendNotification": `false`, "roleIds": `[]`, "visibility": `"public"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newRecordingServer(t, shared.GroupPost{ID: "post_1"})
+			if _, err := c.UpdateGroupPost(context.Background(), "grp_1", "post_1", tt.req); err != nil {
+				t.Fatal(err)
+			}
+			req := (*requests)[0]
+			if req.Method != "PUT" || req.Path != "/groups/grp_1/posts/post_1" {
+				t.Errorf("request = %s %s, want PUT /groups/grp_1/posts/post_1", req.Method, req.Path)
+			}
+			assertBody(t, req.Body, tt.want)
+		})
+	}
+}
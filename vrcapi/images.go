@@ -0,0 +1,249 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // image.DecodeConfig で JPEG を扱うため
+	_ "image/png"  // image.DecodeConfig で PNG を扱うため
+	"io"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+const (
+	// maxImageSize はアップロードできる画像の最大サイズです
+	maxImageSize = 10 * 1024 * 1024
+	// minImageDimension は画像の幅と高さの最小値です
+	minImageDimension = 64
+	// maxImageDimension は画像の幅と高さの最大値です
+	maxImageDimension = 2048
+	// maxEmojiFrames はアニメーションエモジの最大フレーム数です
+	maxEmojiFrames = 64
+	// maxEmojiFramesOverTime はアニメーションエモジの1秒あたりの最大フレーム数です
+	maxEmojiFramesOverTime = 64
+)
+
+// imageConstraint は画像の種類ごとの制約です
+type imageConstraint struct {
+	formats []string // image.DecodeConfig が返す形式名
+	square  bool
+}
+
+// imageConstraints は画像の種類ごとの制約です
+var imageConstraints = map[shared.ImageTag]imageConstraint{
+	shared.ImageTagGallery:       {formats: []string{"png", "jpeg"}},
+	shared.ImageTagIcon:          {formats: []string{"png", "jpeg"}},
+	shared.ImageTagEmoji:         {formats: []string{"png"}, square: true},
+	shared.ImageTagEmojiAnimated: {formats: []string{"png"}, square: true},
+	shared.ImageTagSticker:       {formats: []string{"png"}},
+}
+
+// UploadImage はユーザー画像をアップロードします。
+// アップロード前に形式・サイズ・寸法を画像の種類ごとの制約に照らして検証します。
+func (c *Client) UploadImage(ctx context.Context, r io.Reader, opts shared.UploadImageOptions) (*shared.File, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	format, err := validateImage(data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = "image." + format
+	}
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	fields := [][2]string{{"tag", string(opts.Tag)}}
+	if opts.AnimationStyle != "" {
+		fields = append(fields, [2]string{"animationStyle", opts.AnimationStyle})
+	}
+	if opts.MaskTag != "" {
+		fields = append(fields, [2]string{"maskTag", opts.MaskTag})
+	}
+	if opts.Tag == shared.ImageTagEmojiAnimated {
+		fields = append(fields,
+			[2]string{"frames", strconv.Itoa(opts.Frames)},
+			[2]string{"framesOverTime", strconv.Itoa(opts.FramesOverTime)},
+		)
+	}
+	for _, field := range fields {
+		if err := form.WriteField(field[0], field[1]); err != nil {
+			return nil, fmt.Errorf("failed to build form: %w", err)
+		}
+	}
+	part, err := form.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+
+	var file shared.File
+	err = c.doPayload(ctx, "POST", "/file/image", buf.Bytes(), &file, func(req *http.Request) {
+		req.Header.Set("Content-Type", form.FormDataContentType())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+	return &file, nil
+}
+
+// UploadGalleryImage はギャラリー画像をアップロードします
+func (c *Client) UploadGalleryImage(ctx context.Context, r io.Reader) (*shared.File, error) {
+	return c.UploadImage(ctx, r, shared.UploadImageOptions{Tag: shared.ImageTagGallery})
+}
+
+// UploadIcon はユーザーアイコンをアップロードします
+func (c *Client) UploadIcon(ctx context.Context, r io.Reader) (*shared.File, error) {
+	return c.UploadImage(ctx, r, shared.UploadImageOptions{Tag: shared.ImageTagIcon})
+}
+
+// UploadEmoji はエモジをアップロードします
+func (c *Client) UploadEmoji(ctx context.Context, r io.Reader, animationStyle string) (*shared.File, error) {
+	return c.UploadImage(ctx, r, shared.UploadImageOptions{
+		Tag:            shared.ImageTagEmoji,
+		AnimationStyle: animationStyle,
+	})
+}
+
+// UploadAnimatedEmoji はスプライトシート形式のアニメーションエモジをアップロードします
+func (c *Client) UploadAnimatedEmoji(ctx context.Context, r io.Reader, frames, framesOverTime int, animationStyle string) (*shared.File, error) {
+	return c.UploadImage(ctx, r, shared.UploadImageOptions{
+		Tag:            shared.ImageTagEmojiAnimated,
+		AnimationStyle: animationStyle,
+		Frames:         frames,
+		FramesOverTime: framesOverTime,
+	})
+}
+
+// UploadSticker はステッカーをアップロードします
+func (c *Client) UploadSticker(ctx context.Context, r io.Reader, maskTag string) (*shared.File, error) {
+	return c.UploadImage(ctx, r, shared.UploadImageOptions{
+		Tag:     shared.ImageTagSticker,
+		MaskTag: maskTag,
+	})
+}
+
+// ListImages は指定された種類のユーザー画像のリストを取得します
+func (c *Client) ListImages(ctx context.Context, tag shared.ImageTag, n, offset int) ([]shared.File, error) {
+	return c.GetFiles(ctx, shared.GetFilesOptions{
+		Tag:    string(tag),
+		N:      n,
+		Offset: offset,
+	})
+}
+
+// ListGalleryImages はギャラリー画像のリストを取得します
+func (c *Client) ListGalleryImages(ctx context.Context, n, offset int) ([]shared.File, error) {
+	return c.ListImages(ctx, shared.ImageTagGallery, n, offset)
+}
+
+// ListIcons はユーザーアイコンのリストを取得します
+func (c *Client) ListIcons(ctx context.Context, n, offset int) ([]shared.File, error) {
+	return c.ListImages(ctx, shared.ImageTagIcon, n, offset)
+}
+
+// ListEmoji はエモジのリストを取得します。
+// アニメーションエモジは別の種類として扱われるため ListAnimatedEmoji で取得します。
+func (c *Client) ListEmoji(ctx context.Context, n, offset int) ([]shared.File, error) {
+	return c.ListImages(ctx, shared.ImageTagEmoji, n, offset)
+}
+
+// ListAnimatedEmoji はアニメーションエモジのリストを取得します
+func (c *Client) ListAnimatedEmoji(ctx context.Context, n, offset int) ([]shared.File, error) {
+	return c.ListImages(ctx, shared.ImageTagEmojiAnimated, n, offset)
+}
+
+// ListStickers はステッカーのリストを取得します
+func (c *Client) ListStickers(ctx context.Context, n, offset int) ([]shared.File, error) {
+	return c.ListImages(ctx, shared.ImageTagSticker, n, offset)
+}
+
+// DeleteImage は指定された種類のユーザー画像を削除します。
+// 誤って別の種類のファイルを削除しないよう、削除前にファイルのタグを確認します。
+func (c *Client) DeleteImage(ctx context.Context, fileID string, tags ...shared.ImageTag) error {
+	file, err := c.GetFile(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	if len(tags) > 0 && !slices.ContainsFunc(tags, func(tag shared.ImageTag) bool {
+		return slices.Contains(file.Tags, string(tag))
+	}) {
+		return fmt.Errorf("failed to delete image: file %s is not tagged %v", fileID, tags)
+	}
+	return c.DeleteFile(ctx, fileID)
+}
+
+// DeleteGalleryImage はギャラリー画像を削除します
+func (c *Client) DeleteGalleryImage(ctx context.Context, fileID string) error {
+	return c.DeleteImage(ctx, fileID, shared.ImageTagGallery)
+}
+
+// DeleteIcon はユーザーアイコンを削除します
+func (c *Client) DeleteIcon(ctx context.Context, fileID string) error {
+	return c.DeleteImage(ctx, fileID, shared.ImageTagIcon)
+}
+
+// DeleteEmoji はエモジ（アニメーションエモジを含む）を削除します
+func (c *Client) DeleteEmoji(ctx context.Context, fileID string) error {
+	return c.DeleteImage(ctx, fileID, shared.ImageTagEmoji, shared.ImageTagEmojiAnimated)
+}
+
+// DeleteSticker はステッカーを削除します
+func (c *Client) DeleteSticker(ctx context.Context, fileID string) error {
+	return c.DeleteImage(ctx, fileID, shared.ImageTagSticker)
+}
+
+// validateImage は画像の形式・サイズ・寸法を検証し、形式名を返します
+func validateImage(data []byte, opts shared.UploadImageOptions) (string, error) {
+	constraint, ok := imageConstraints[opts.Tag]
+	if !ok {
+		return "", fmt.Errorf("invalid image: unsupported tag %q", opts.Tag)
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("invalid image: empty")
+	}
+	if len(data) > maxImageSize {
+		return "", fmt.Errorf("invalid image: larger than %d bytes", maxImageSize)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("invalid image: %w", err)
+	}
+	if !slices.Contains(constraint.formats, format) {
+		return "", fmt.Errorf("invalid image: %s is not allowed for %s (allowed: %v)", format, opts.Tag, constraint.formats)
+	}
+	if config.Width < minImageDimension || config.Height < minImageDimension ||
+		config.Width > maxImageDimension || config.Height > maxImageDimension {
+		return "", fmt.Errorf("invalid image: %dx%d is out of range (%d-%d)",
+			config.Width, config.Height, minImageDimension, maxImageDimension)
+	}
+	if constraint.square && config.Width != config.Height {
+		return "", fmt.Errorf("invalid image: %s must be square, got %dx%d", opts.Tag, config.Width, config.Height)
+	}
+
+	if opts.Tag == shared.ImageTagEmojiAnimated {
+		if opts.Frames < 2 || opts.Frames > maxEmojiFrames {
+			return "", fmt.Errorf("invalid image: frames must be between 2 and %d", maxEmojiFrames)
+		}
+		if opts.FramesOverTime < 1 || opts.FramesOverTime > maxEmojiFramesOverTime {
+			return "", fmt.Errorf("invalid image: framesOverTime must be between 1 and %d", maxEmojiFramesOverTime)
+		}
+	}
+	return format, nil
+}
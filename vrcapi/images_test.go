@@ -0,0 +1,223 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestValidateImage(t *testing.T) {
+	square := encodePNG(t, 256, 256)
+	animated := shared.UploadImageOptions{Tag: shared.ImageTagEmojiAnimated, Frames: 16, FramesOverTime: 8}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		opts    shared.UploadImageOptions
+		want    string
+		wantErr string
+	}{
+		{name: "gallery png", data: encodePNG(t, 1200, 900), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, want: "png"},
+		{name: "gallery jpeg", data: encodeJPEG(t, 1200, 900), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, want: "jpeg"},
+		{name: "icon jpeg", data: encodeJPEG(t, 512, 512), opts: shared.UploadImageOptions{Tag: shared.ImageTagIcon}, want: "jpeg"},
+		{name: "emoji png", data: square, opts: shared.UploadImageOptions{Tag: shared.ImageTagEmoji}, want: "png"},
+		{name: "sticker png", data: encodePNG(t, 512, 256), opts: shared.UploadImageOptions{Tag: shared.ImageTagSticker}, want: "png"},
+		{name: "animated emoji", data: square, opts: animated, want: "png"},
+		{name: "minimum size", data: encodePNG(t, 64, 64), opts: shared.UploadImageOptions{Tag: shared.ImageTagEmoji}, want: "png"},
+		{name: "maximum size", data: encodePNG(t, 2048, 2048), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, want: "png"},
+
+		{name: "unknown tag", data: square, opts: shared.UploadImageOptions{Tag: "banner"}, wantErr: "unsupported tag"},
+		{name: "empty", data: nil, opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, wantErr: "empty"},
+		{name: "too large", data: make([]byte, maxImageSize+1), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, wantErr: "larger than"},
+		{name: "not an image", data: []byte("GIF89a not really"), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, wantErr: "invalid image"},
+		// エモジとステッカーは PNG のみ
+		{name: "emoji jpeg", data: encodeJPEG(t, 256, 256), opts: shared.UploadImageOptions{Tag: shared.ImageTagEmoji}, wantErr: "jpeg is not allowed"},
+		{name: "sticker jpeg", data: encodeJPEG(t, 256, 256), opts: shared.UploadImageOptions{Tag: shared.ImageTagSticker}, wantErr: "jpeg is not allowed"},
+		{name: "too small", data: encodePNG(t, 63, 64), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, wantErr: "out of range"},
+		{name: "too big", data: encodePNG(t, 2049, 100), opts: shared.UploadImageOptions{Tag: shared.ImageTagGallery}, wantErr: "out of range"},
+		{name: "emoji not square", data: encodePNG(t, 256, 128), opts: shared.UploadImageOptions{Tag: shared.ImageTagEmoji}, wantErr: "must be square"},
+		{name: "animated too few frames", data: square, opts: shared.UploadImageOptions{Tag: shared.ImageTagEmojiAnimated, Frames: 1, FramesOverTime: 8}, wantErr: "frames must be"},
+		{name: "animated too many frames", data: square, opts: shared.UploadImageOptions{Tag: shared.ImageTagEmojiAnimated, Frames: 65, FramesOverTime: 8}, wantErr: "frames must be"},
+		{name: "animated no frame rate", data: square, opts: shared.UploadImageOptions{Tag: shared.ImageTagEmojiAnimated, Frames: 16}, wantErr: "framesOverTime must be"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateImage(tt.data, tt.opts)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("validateImage() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("validateImage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUploadImage(t *testing.T) {
+	data := encodePNG(t, 256, 256)
+	var form map[string]string
+	var fileName string
+	var fileData []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/file/image" {
+			t.Errorf("request = %s %s, want POST /file/image", r.Method, r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(maxImageSize); err != nil {
+			t.Fatal(err)
+		}
+		form = make(map[string]string)
+		for k, v := range r.MultipartForm.Value {
+			form[k] = v[0]
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		fileName = header.Filename
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(file)
+		fileData = buf.Bytes()
+		writeJSON(w, shared.File{ID: "file_1", Tags: []string{string(shared.ImageTagEmojiAnimated)}})
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := c.UploadAnimatedEmoji(context.Background(), bytes.NewReader(data), 16, 8, "bats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ID != "file_1" {
+		t.Errorf("UploadAnimatedEmoji() = %+v", file)
+	}
+	want := map[string]string{"tag": "emojianimated", "animationStyle": "bats", "frames": "16", "framesOverTime": "8"}
+	if len(form) != len(want) {
+		t.Errorf("form = %v, want %v", form, want)
+	}
+	for k, v := range want {
+		if form[k] != v {
+			t.Errorf("form[%q] = %q, want %q", k, form[k], v)
+		}
+	}
+	if fileName != "image.png" || !bytes.Equal(fileData, data) {
+		t.Errorf("file = %q (%d bytes), want image.png (%d bytes)", fileName, len(fileData), len(data))
+	}
+
+	// 検証に失敗した画像は送信しない
+	form = nil
+	if _, err := c.UploadEmoji(context.Background(), bytes.NewReader(encodeJPEG(t, 256, 256)), ""); err == nil {
+		t.Error("UploadEmoji() with JPEG succeeded, want error")
+	}
+	if form != nil {
+		t.Error("invalid image was uploaded")
+	}
+}
+
+func TestListEmoji(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		writeJSON(w, []shared.File{{ID: "file_" + r.URL.Query().Get("offset")}})
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := c.ListEmoji(ctx, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListEmoji(ctx, 20, 40); err != nil {
+		t.Fatal(err)
+	}
+	files, err := c.ListAnimatedEmoji(ctx, 10, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].ID != "file_10" {
+		t.Errorf("ListAnimatedEmoji() = %+v", files)
+	}
+
+	// アニメーションエモジは別のタグで取得する
+	want := []string{
+		"n=60&tag=emoji",
+		"n=20&offset=40&tag=emoji",
+		"n=10&offset=10&tag=emojianimated",
+	}
+	if strings.Join(queries, " ") != strings.Join(want, " ") {
+		t.Errorf("queries = %q, want %q", queries, want)
+	}
+}
+
+func TestDeleteEmojiChecksTag(t *testing.T) {
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/file/")
+		switch r.Method {
+		case "GET":
+			tags := map[string][]string{
+				"file_emoji":    {"emoji"},
+				"file_animated": {"emojianimated"},
+				"file_gallery":  {"gallery"},
+			}[id]
+			writeJSON(w, shared.File{ID: id, Tags: tags})
+		case "DELETE":
+			deleted = append(deleted, id)
+			writeJSON(w, shared.File{ID: id})
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"file_emoji", "file_animated"} {
+		if err := c.DeleteEmoji(context.Background(), id); err != nil {
+			t.Errorf("DeleteEmoji(%q) = %v", id, err)
+		}
+	}
+	if err := c.DeleteEmoji(context.Background(), "file_gallery"); err == nil {
+		t.Error("DeleteEmoji(file_gallery) succeeded, want error")
+	}
+	if strings.Join(deleted, " ") != "file_emoji file_animated" {
+		t.Errorf("deleted = %v", deleted)
+	}
+}
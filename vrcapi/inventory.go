@@ -0,0 +1,105 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// inventoryPageSize はインベントリのデフォルトの取得件数です
+const inventoryPageSize = 100
+
+// GetInventory は現在のユーザーのインベントリを取得します
+func (c *Client) GetInventory(ctx context.Context, opts shared.GetInventoryOptions) (*shared.InventoryPage, error) {
+	params := url.Values{}
+	if opts.N > 0 {
+		params.Set("n", strconv.Itoa(opts.N))
+	} else {
+		params.Set("n", strconv.Itoa(inventoryPageSize))
+	}
+	if opts.Offset > 0 {
+		params.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if opts.Order != "" {
+		params.Set("order", opts.Order)
+	}
+	if len(opts.Types) > 0 {
+		types := make([]string, len(opts.Types))
+		for i, t := range opts.Types {
+			types[i] = string(t)
+		}
+		params.Set("types", strings.Join(types, ","))
+	}
+	if len(opts.Tags) > 0 {
+		params.Set("tags", strings.Join(opts.Tags, ","))
+	}
+
+	var page shared.InventoryPage
+	err := c.doRequest(ctx, "GET", "/inventory?"+params.Encode(), nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	return &page, nil
+}
+
+// AllInventoryItems はインベントリのすべてのアイテムを順に返すイテレータです。
+// opts の N と Offset は無視されます。
+func (c *Client) AllInventoryItems(ctx context.Context, opts shared.GetInventoryOptions) iter.Seq2[shared.InventoryItem, error] {
+	return paginateCounted(ctx, inventoryPageSize, func(ctx context.Context, n, offset int) ([]shared.InventoryItem, int, error) {
+		opts.N, opts.Offset = n, offset
+		page, err := c.GetInventory(ctx, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Data, page.TotalCount, nil
+	})
+}
+
+// GetInventoryItem は指定されたユーザーのインベントリアイテムを取得します
+func (c *Client) GetInventoryItem(ctx context.Context, userID, itemID string) (*shared.InventoryItem, error) {
+	var item shared.InventoryItem
+	err := c.doRequest(ctx, "GET", "/user/"+userID+"/inventory/"+itemID, nil, &item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+	return &item, nil
+}
+
+// ConsumeInventoryItem はインベントリアイテム（バンドルなど）を使用し、得られたアイテムを返します
+func (c *Client) ConsumeInventoryItem(ctx context.Context, itemID string) ([]shared.InventoryItem, error) {
+	var result struct {
+		Items []shared.InventoryItem `json:"inventoryItems"`
+	}
+	err := c.doRequest(ctx, "PUT", "/inventory/"+itemID+"/consume", nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume inventory item: %w", err)
+	}
+	return result.Items, nil
+}
+
+// EquipInventoryItem はエモジやステッカーなどのインベントリアイテムを装備します
+func (c *Client) EquipInventoryItem(ctx context.Context, itemID, equipSlot string) (*shared.InventoryItem, error) {
+	var item shared.InventoryItem
+	req := struct {
+		EquipSlot string `json:"equipSlot,omitempty"`
+	}{EquipSlot: equipSlot}
+	err := c.doRequest(ctx, "PUT", "/inventory/"+itemID+"/equip", req, &item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to equip inventory item: %w", err)
+	}
+	return &item, nil
+}
+
+// UnequipInventoryItem はインベントリアイテムの装備を解除します
+func (c *Client) UnequipInventoryItem(ctx context.Context, itemID string) error {
+	err := c.doRequest(ctx, "DELETE", "/inventory/"+itemID+"/equip", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to unequip inventory item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package vrcapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// InviteUser はユーザーをインスタンスに招待します。
+// messageSlot に招待メッセージのスロットを指定するとメッセージを添付します（負の値の場合はメッセージなし）。
+func (c *Client) InviteUser(ctx context.Context, userID, location string, messageSlot int) (*shared.Notification, error) {
+	req := shared.InviteUserRequest{InstanceID: location}
+	if messageSlot >= 0 {
+		req.MessageSlot = &messageSlot
+	}
+
+	var notification shared.Notification
+	err := c.doRequest(ctx, "POST", "/invite/"+userID, req, &notification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite user: %w", err)
+	}
+	return &notification, nil
+}
+
+// RequestInvite はユーザーに招待をリクエストします。
+// requestSlot に招待リクエストメッセージのスロットを指定するとメッセージを添付します（負の値の場合はメッセージなし）。
+func (c *Client) RequestInvite(ctx context.Context, userID string, requestSlot int) (*shared.Notification, error) {
+	var req shared.RequestInviteRequest
+	if requestSlot >= 0 {
+		req.RequestSlot = &requestSlot
+	}
+
+	var notification shared.Notification
+	err := c.doRequest(ctx, "POST", "/requestInvite/"+userID, req, &notification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request invite: %w", err)
+	}
+	return &notification, nil
+}
+
+// RespondInvite は招待または招待リクエストの通知に、招待応答メッセージで応答します
+func (c *Client) RespondInvite(ctx context.Context, notificationID string, responseSlot int) (*shared.Notification, error) {
+	var notification shared.Notification
+	req := shared.RespondInviteRequest{ResponseSlot: responseSlot}
+	err := c.doRequest(ctx, "POST", "/invite/"+notificationID+"/response", req, &notification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to respond to invite: %w", err)
+	}
+	return &notification, nil
+}
+
+// GetInviteMessages は指定された種類の招待メッセージのリストを取得します
+func (c *Client) GetInviteMessages(ctx context.Context, userID string, messageType shared.InviteMessageType) ([]shared.InviteMessage, error) {
+	var messages []shared.InviteMessage
+	err := c.doRequest(ctx, "GET", "/message/"+userID+"/"+string(messageType), nil, &messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite messages: %w", err)
+	}
+	return messages, nil
+}
+
+// GetInviteMessage は指定されたスロットの招待メッセージを取得します
+func (c *Client) GetInviteMessage(ctx context.Context, userID string, messageType shared.InviteMessageType, slot int) (*shared.InviteMessage, error) {
+	var message shared.InviteMessage
+	path := fmt.Sprintf("/message/%s/%s/%d", userID, messageType, slot)
+	err := c.doRequest(ctx, "GET", path, nil, &message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite message: %w", err)
+	}
+	return &message, nil
+}
+
+// UpdateInviteMessage は招待メッセージを更新し、更新後のリストを返します。
+// 招待メッセージは一度更新すると一定時間更新できないため、事前にスロットの状態を確認し、
+// クールダウン中の場合は *shared.InviteMessageCooldownError を返します。
+func (c *Client) UpdateInviteMessage(ctx context.Context, userID string, messageType shared.InviteMessageType, slot int, message string) ([]shared.InviteMessage, error) {
+	current, err := c.GetInviteMessage(ctx, userID, messageType, slot)
+	if err != nil {
+		return nil, err
+	}
+	if !current.CanBeUpdated {
+		return nil, &shared.InviteMessageCooldownError{
+			MessageType: messageType,
+			Slot:        slot,
+			Remaining:   time.Duration(current.RemainingCooldownMinutes) * time.Minute,
+		}
+	}
+
+	var messages []shared.InviteMessage
+	path := fmt.Sprintf("/message/%s/%s/%d", userID, messageType, slot)
+	err = c.doRequest(ctx, "PUT", path, shared.UpdateInviteMessageRequest{Message: message}, &messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update invite message: %w", err)
+	}
+	return messages, nil
+}
+
+// ResetInviteMessage は招待メッセージをデフォルトに戻し、リセット後のリストを返します
+func (c *Client) ResetInviteMessage(ctx context.Context, userID string, messageType shared.InviteMessageType, slot int) ([]shared.InviteMessage, error) {
+	var messages []shared.InviteMessage
+	path := fmt.Sprintf("/message/%s/%s/%d", userID, messageType, slot)
+	err := c.doRequest(ctx, "DELETE", path, nil, &messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset invite message: %w", err)
+	}
+	return messages, nil
+}
@@ -0,0 +1,121 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestInviteUserMessageSlot(t *testing.T) {
+	tests := []struct {
+		name string
+		slot int
+		want map[string]string
+	}{
+		{name: "no message", slot: -1, want: map[string]string{"instanceId": `"wrld_1:123"`}},
+		// スロット0も有効なスロットとして送信する
+		{name: "slot 0", slot: 0, want: map[string]string{"instanceId": `"wrld_1:123"`, "messageSlot": `0`}},
+		{name: "slot 5", slot: 5, want: map[string]string{"instanceId": `"wrld_1:123"`, "messageSlot": `5`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newRecordingServer(t, shared.Notification{ID: "not_1"})
+			if _, err := c.InviteUser(context.Background(), "usr_1", "wrld_1:123", tt.slot); err != nil {
+				t.Fatal(err)
+			}
+			req := (*requests)[0]
+			if req.Method != "POST" || req.Path != "/invite/usr_1" {
+				t.Errorf("request = %s %s, want POST /invite/usr_1", req.Method, req.Path)
+			}
+			assertBody(t, req.Body, tt.want)
+		})
+	}
+}
+
+func TestRequestInviteMessageSlot(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.Notification{ID: "not_1"})
+	if _, err := c.RequestInvite(context.Background(), "usr_1", -1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.RequestInvite(context.Background(), "usr_1", 2); err != nil {
+		t.Fatal(err)
+	}
+	assertBody(t, (*requests)[0].Body, map[string]string{})
+	assertBody(t, (*requests)[1].Body, map[string]string{"requestSlot": `2`})
+}
+
+func TestUpdateInviteMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		current       shared.InviteMessage
+		wantRemaining time.Duration
+		wantUpdated   bool
+	}{
+		{
+			name:        "can be updated",
+			current:     shared.InviteMessage{Slot: 3, CanBeUpdated: true},
+			wantUpdated: true,
+		},
+		{
+			// クールダウン中は更新リクエストを送らずに残り時間を返す
+			name:          "cooldown",
+			current:       shared.InviteMessage{Slot: 3, CanBeUpdated: false, RemainingCooldownMinutes: 42},
+			wantRemaining: 42 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/message/usr_1/message/3" {
+					t.Errorf("path = %s, want /message/usr_1/message/3", r.URL.Path)
+				}
+				switch r.Method {
+				case "GET":
+					writeJSON(w, tt.current)
+				case "PUT":
+					var req shared.UpdateInviteMessageRequest
+					data, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(data, &req); err != nil {
+						t.Error(err)
+					}
+					updated = req.Message
+					writeJSON(w, []shared.InviteMessage{{Slot: 3, Message: req.Message}})
+				}
+			}))
+			defer srv.Close()
+			c, err := NewClient(WithBaseURL(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			messages, err := c.UpdateInviteMessage(context.Background(), "usr_1", shared.InviteMessageTypeMessage, 3, "Come join!")
+			if !tt.wantUpdated {
+				var cooldown *shared.InviteMessageCooldownError
+				if !errors.As(err, &cooldown) {
+					t.Fatalf("err = %v, want InviteMessageCooldownError", err)
+				}
+				if cooldown.Remaining != tt.wantRemaining || cooldown.Slot != 3 || cooldown.MessageType != shared.InviteMessageTypeMessage {
+					t.Errorf("cooldown = %+v", cooldown)
+				}
+				if updated != "" {
+					t.Error("message was updated during cooldown")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated != "Come join!" || len(messages) != 1 || messages[0].Message != "Come join!" {
+				t.Errorf("updated = %q, messages = %+v", updated, messages)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package vrcapi
 
 import (
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -34,3 +35,43 @@ func WithHTTPClient(httpClient *http.Client) Option {
 func WithBaseURL(baseURL string) Option {
 	return shared.WithBaseURL(baseURL)
 }
+
+// WithRawResponseHandler はレスポンスの生のボディを受け取るハンドラーを設定します。
+// ハンドラーはデコード後に呼び出され、デコード結果も併せて参照できます。
+func WithRawResponseHandler(handler func(resp *shared.RawResponse)) Option {
+	return shared.WithRawResponseHandler(handler)
+}
+
+// WithSchemaDriftHandler はスキーマ差分の検出を有効にし、検出した差分をハンドラーに渡します。
+// 差分はエンドポイントごとに重複を除いて一度だけ報告されます。
+func WithSchemaDriftHandler(handler func(drift shared.SchemaDrift)) Option {
+	return shared.WithSchemaDriftHandler(handler)
+}
+
+// WithSchemaDriftLogger はスキーマ差分の検出を有効にし、検出した差分をロガーに出力します
+func WithSchemaDriftLogger(logger *slog.Logger) Option {
+	return shared.WithSchemaDriftLogger(logger)
+}
+
+// WithCache はレスポンスキャッシュを設定します。
+// キャッシュされるのは "/worlds/{id}" のような単一リソースを取得するGETリクエストです。
+func WithCache(cache Cache) Option {
+	return shared.WithCache(cache)
+}
+
+// WithCacheTTL はリソース（"users", "worlds" などパスの先頭要素）ごとのキャッシュ有効期間を設定します。
+// 0 を指定するとそのリソースはキャッシュされません。
+func WithCacheTTL(resource string, ttl time.Duration) Option {
+	return shared.WithCacheTTL(resource, ttl)
+}
+
+// WithRequestCoalescing は同時に発行された同一のGETリクエストの集約を有効または無効にします（デフォルトは有効）
+func WithRequestCoalescing(enabled bool) Option {
+	return shared.WithRequestCoalescing(enabled)
+}
+
+// WithRateLimit は1秒あたりのリクエスト数の上限とバースト数を設定します。
+// 上限に達したリクエストはトークンが補充されるまで待機します。
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return shared.WithRateLimit(requestsPerSecond, burst)
+}
@@ -0,0 +1,53 @@
+package vrcapi
+
+import (
+	"context"
+	"iter"
+)
+
+// paginate はページ単位の取得関数から、全ページの要素を順に返すイテレータを作成します。
+// サーバーが pageSize より少ない件数で区切る場合があるため、空のページを取得した時点で終了します。
+// エラーが発生した場合はゼロ値とエラーを返して終了します。
+func paginate[T any](ctx context.Context, pageSize int, fetch func(ctx context.Context, n, offset int) ([]T, error)) iter.Seq2[T, error] {
+	return paginateCounted(ctx, pageSize, func(ctx context.Context, n, offset int) ([]T, int, error) {
+		page, err := fetch(ctx, n, offset)
+		return page, -1, err
+	})
+}
+
+// paginateCounted は総件数を返す取得関数から、全ページの要素を順に返すイテレータを作成します。
+// 総件数が負の場合は不明として扱い、paginate と同様に空のページで終了します。
+func paginateCounted[T any](ctx context.Context, pageSize int, fetch func(ctx context.Context, n, offset int) ([]T, int, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := 0
+		for {
+			page, total, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			offset += len(page)
+			if len(page) == 0 || (total >= 0 && offset >= total) {
+				return
+			}
+		}
+	}
+}
+
+// collect はイテレータのすべての要素をスライスにまとめ、最初のエラーを返します
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
@@ -0,0 +1,144 @@
+package vrcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// pagedFetch は items を最大 limit 件ずつ返す取得関数と、呼び出されたオフセットを返します
+func pagedFetch(items []int, limit int) (func(ctx context.Context, n, offset int) ([]int, error), *[]int) {
+	var offsets []int
+	return func(ctx context.Context, n, offset int) ([]int, error) {
+		offsets = append(offsets, offset)
+		end := min(offset+min(n, limit), len(items))
+		if offset >= end {
+			return []int{}, nil
+		}
+		return items[offset:end], nil
+	}, &offsets
+}
+
+func TestPaginate(t *testing.T) {
+	items := make([]int, 25)
+	for i := range items {
+		items[i] = i
+	}
+
+	tests := []struct {
+		name        string
+		limit       int
+		wantOffsets []int
+	}{
+		{name: "full pages", limit: 10, wantOffsets: []int{0, 10, 20, 25}},
+		// サーバーが pageSize より少ない件数で区切っても途中で終了しない
+		{name: "short pages", limit: 7, wantOffsets: []int{0, 7, 14, 21, 25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch, offsets := pagedFetch(items, tt.limit)
+			got, err := collect(paginate(context.Background(), 10, fetch))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, items) {
+				t.Errorf("items = %v, want %v", got, items)
+			}
+			if !slices.Equal(*offsets, tt.wantOffsets) {
+				t.Errorf("offsets = %v, want %v", *offsets, tt.wantOffsets)
+			}
+		})
+	}
+}
+
+func TestPaginateEarlyBreak(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	fetch, offsets := pagedFetch(items, 3)
+
+	var got []int
+	for item, err := range paginate(context.Background(), 3, fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+		if item == 4 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("items = %v", got)
+	}
+	// 中断した後のページは取得しない
+	if !slices.Equal(*offsets, []int{0, 3}) {
+		t.Errorf("offsets = %v, want [0 3]", *offsets)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	calls := 0
+	fetch := func(ctx context.Context, n, offset int) ([]int, error) {
+		calls++
+		if offset > 0 {
+			return nil, errFetch
+		}
+		return []int{1, 2}, nil
+	}
+
+	var got []int
+	var gotErr error
+	for item, err := range paginate(context.Background(), 2, fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, item)
+	}
+	if !slices.Equal(got, []int{1, 2}) || !errors.Is(gotErr, errFetch) {
+		t.Errorf("items = %v, err = %v", got, gotErr)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+
+	if _, err := collect(paginate(context.Background(), 2, fetch)); !errors.Is(err, errFetch) {
+		t.Errorf("collect error = %v, want %v", err, errFetch)
+	}
+}
+
+func TestAllInventoryItemsUsesTotalCount(t *testing.T) {
+	var offsets []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, offset)
+		// 1ページ60件で区切り、全体で130件
+		page := shared.InventoryPage{TotalCount: 130}
+		for i := offset; i < min(offset+60, 130); i++ {
+			page.Data = append(page.Data, shared.InventoryItem{ID: "inv_" + strconv.Itoa(i)})
+		}
+		writeJSON(w, page)
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := collect(c.AllInventoryItems(context.Background(), shared.GetInventoryOptions{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 130 || items[129].ID != "inv_129" {
+		t.Errorf("got %d items", len(items))
+	}
+	// 総件数に達したら空のページを取得せずに終了する
+	if !slices.Equal(offsets, []int{0, 60, 120}) {
+		t.Errorf("offsets = %v, want [0 60 120]", offsets)
+	}
+}
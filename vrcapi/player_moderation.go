@@ -3,16 +3,26 @@ package vrcapi
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sort"
 
 	"github.com/kqnade/vrcgo/shared"
 )
 
 // GetPlayerModerations はプレイヤーモデレーションのリストを取得します
-func (c *Client) GetPlayerModerations(ctx context.Context, moderationType string) ([]shared.PlayerModeration, error) {
+func (c *Client) GetPlayerModerations(ctx context.Context, opts shared.GetPlayerModerationsOptions) ([]shared.PlayerModeration, error) {
+	params := url.Values{}
+	if opts.Type != "" {
+		params.Set("type", string(opts.Type))
+	}
+	if opts.TargetUserID != "" {
+		params.Set("targetUserId", opts.TargetUserID)
+	}
+
 	var moderations []shared.PlayerModeration
 	path := "/auth/user/playermoderations"
-	if moderationType != "" {
-		path += "?type=" + moderationType
+	if len(params) > 0 {
+		path += "?" + params.Encode()
 	}
 	err := c.doRequest(ctx, "GET", path, nil, &moderations)
 	if err != nil {
@@ -21,12 +31,22 @@ func (c *Client) GetPlayerModerations(ctx context.Context, moderationType string
 	return moderations, nil
 }
 
+// GetPlayerModeration は指定されたIDのプレイヤーモデレーションを取得します
+func (c *Client) GetPlayerModeration(ctx context.Context, moderationID string) (*shared.PlayerModeration, error) {
+	var moderation shared.PlayerModeration
+	err := c.doRequest(ctx, "GET", "/auth/user/playermoderations/"+moderationID, nil, &moderation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player moderation: %w", err)
+	}
+	return &moderation, nil
+}
+
 // ModeratePlayer はプレイヤーをモデレートします
-func (c *Client) ModeratePlayer(ctx context.Context, moderatedUserID, moderationType string) (*shared.PlayerModeration, error) {
+func (c *Client) ModeratePlayer(ctx context.Context, moderatedUserID string, moderationType shared.ModerationType) (*shared.PlayerModeration, error) {
 	var moderation shared.PlayerModeration
 	req := struct {
-		ModeratedUserID string `json:"moderated"`
-		Type            string `json:"type"`
+		ModeratedUserID string                `json:"moderated"`
+		Type            shared.ModerationType `json:"type"`
 	}{
 		ModeratedUserID: moderatedUserID,
 		Type:            moderationType,
@@ -39,19 +59,20 @@ func (c *Client) ModeratePlayer(ctx context.Context, moderatedUserID, moderation
 }
 
 // UnmoderatePlayer はプレイヤーのモデレーションを解除します
-func (c *Client) UnmoderatePlayer(ctx context.Context, moderatedUserID, moderationType string) error {
+func (c *Client) UnmoderatePlayer(ctx context.Context, moderatedUserID string, moderationType shared.ModerationType) (*shared.SuccessResponse, error) {
+	var response shared.SuccessResponse
 	req := struct {
-		ModeratedUserID string `json:"moderated"`
-		Type            string `json:"type"`
+		ModeratedUserID string                `json:"moderated"`
+		Type            shared.ModerationType `json:"type"`
 	}{
 		ModeratedUserID: moderatedUserID,
 		Type:            moderationType,
 	}
-	err := c.doRequest(ctx, "PUT", "/auth/user/unplayermoderate", req, nil)
+	err := c.doRequest(ctx, "PUT", "/auth/user/unplayermoderate", req, &response)
 	if err != nil {
-		return fmt.Errorf("failed to unmoderate player: %w", err)
+		return nil, fmt.Errorf("failed to unmoderate player: %w", err)
 	}
-	return nil
+	return &response, nil
 }
 
 // ClearAllPlayerModerations はすべてのプレイヤーモデレーションをクリアします
@@ -62,3 +83,85 @@ func (c *Client) ClearAllPlayerModerations(ctx context.Context) error {
 	}
 	return nil
 }
+
+// SyncPlayerModerations はモデレーションを desired の状態に同期します。
+// desired はモデレーションの種類ごとの対象ユーザーIDのリストで、desired に含まれる種類だけが管理対象です
+// （空のリストを指定するとその種類のモデレーションをすべて解除します）。
+// 現在の状態との差分から必要最小限の変更を計算して実行し、実行した変更を返します。
+// DryRun の場合は変更を行わず、実行予定の変更を返します。
+// 途中で失敗した場合は、それまでに実行した変更とエラーを返します。
+func (c *Client) SyncPlayerModerations(ctx context.Context, desired map[shared.ModerationType][]string, opts shared.SyncPlayerModerationsOptions) ([]shared.ModerationChange, error) {
+	current, err := c.GetPlayerModerations(ctx, shared.GetPlayerModerationsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffPlayerModerations(current, desired)
+	if opts.DryRun {
+		return changes, nil
+	}
+
+	for i, change := range changes {
+		var err error
+		if change.Action == shared.ModerationActionModerate {
+			_, err = c.ModeratePlayer(ctx, change.UserID, change.Type)
+		} else {
+			_, err = c.UnmoderatePlayer(ctx, change.UserID, change.Type)
+		}
+		if err != nil {
+			return changes[:i], fmt.Errorf("failed to sync player moderations (%s %s %s): %w",
+				change.Action, change.Type, change.UserID, err)
+		}
+	}
+	return changes, nil
+}
+
+// diffPlayerModerations は現在のモデレーションを desired にするための変更を計算します。
+// 結果は種類・操作（解除が先）・ユーザーIDの順に並びます。
+func diffPlayerModerations(current []shared.PlayerModeration, desired map[shared.ModerationType][]string) []shared.ModerationChange {
+	existing := make(map[shared.ModerationType]map[string]struct{})
+	for _, m := range current {
+		if existing[m.Type] == nil {
+			existing[m.Type] = make(map[string]struct{})
+		}
+		existing[m.Type][m.TargetUserID] = struct{}{}
+	}
+
+	var changes []shared.ModerationChange
+	for moderationType, userIDs := range desired {
+		want := make(map[string]struct{}, len(userIDs))
+		for _, id := range userIDs {
+			want[id] = struct{}{}
+		}
+		for id := range existing[moderationType] {
+			if _, ok := want[id]; !ok {
+				changes = append(changes, shared.ModerationChange{
+					Action: shared.ModerationActionUnmoderate,
+					Type:   moderationType,
+					UserID: id,
+				})
+			}
+		}
+		for id := range want {
+			if _, ok := existing[moderationType][id]; !ok {
+				changes = append(changes, shared.ModerationChange{
+					Action: shared.ModerationActionModerate,
+					Type:   moderationType,
+					UserID: id,
+				})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Action != b.Action {
+			return a.Action == shared.ModerationActionUnmoderate
+		}
+		return a.UserID < b.UserID
+	})
+	return changes
+}
@@ -0,0 +1,142 @@
+package vrcapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestDiffPlayerModerations(t *testing.T) {
+	current := []shared.PlayerModeration{
+		{Type: shared.ModerationTypeBlock, TargetUserID: "usr_a"},
+		{Type: shared.ModerationTypeBlock, TargetUserID: "usr_b"},
+		{Type: shared.ModerationTypeMute, TargetUserID: "usr_c"},
+	}
+
+	tests := []struct {
+		name    string
+		desired map[shared.ModerationType][]string
+		want    []shared.ModerationChange
+	}{
+		{
+			name: "add only",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeBlock: {"usr_a", "usr_b", "usr_d"},
+			},
+			want: []shared.ModerationChange{
+				{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeBlock, UserID: "usr_d"},
+			},
+		},
+		{
+			name: "remove only",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeBlock: {"usr_b"},
+			},
+			want: []shared.ModerationChange{
+				{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeBlock, UserID: "usr_a"},
+			},
+		},
+		{
+			name: "already in sync",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeBlock: {"usr_b", "usr_a"},
+				shared.ModerationTypeMute:  {"usr_c"},
+			},
+			want: nil,
+		},
+		{
+			// desired に含まれない種類（mute）は変更しない
+			name: "absent type is untouched",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeHideAvatar: {"usr_c"},
+			},
+			want: []shared.ModerationChange{
+				{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeHideAvatar, UserID: "usr_c"},
+			},
+		},
+		{
+			name: "empty list clears type",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeBlock: {},
+			},
+			want: []shared.ModerationChange{
+				{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeBlock, UserID: "usr_a"},
+				{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeBlock, UserID: "usr_b"},
+			},
+		},
+		{
+			name: "duplicate desired IDs produce one change",
+			desired: map[shared.ModerationType][]string{
+				shared.ModerationTypeMute: {"usr_c", "usr_e", "usr_e"},
+			},
+			want: []shared.ModerationChange{
+				{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeMute, UserID: "usr_e"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffPlayerModerations(current, tt.desired)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diffPlayerModerations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncPlayerModerationsDryRunOrder(t *testing.T) {
+	var writes int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes++
+			http.Error(w, "unexpected write", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, []shared.PlayerModeration{
+			{Type: shared.ModerationTypeMute, TargetUserID: "usr_m2"},
+			{Type: shared.ModerationTypeBlock, TargetUserID: "usr_b3"},
+			{Type: shared.ModerationTypeMute, TargetUserID: "usr_m1"},
+			{Type: shared.ModerationTypeBlock, TargetUserID: "usr_b1"},
+		})
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired := map[shared.ModerationType][]string{
+		shared.ModerationTypeMute:       {},
+		shared.ModerationTypeBlock:      {"usr_b2", "usr_b4", "usr_b1", "usr_b0"},
+		shared.ModerationTypeHideAvatar: {"usr_h2", "usr_h1"},
+	}
+
+	// 種類・操作（解除が先）・ユーザーIDの順
+	want := []shared.ModerationChange{
+		{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeBlock, UserID: "usr_b3"},
+		{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeBlock, UserID: "usr_b0"},
+		{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeBlock, UserID: "usr_b2"},
+		{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeBlock, UserID: "usr_b4"},
+		{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeHideAvatar, UserID: "usr_h1"},
+		{Action: shared.ModerationActionModerate, Type: shared.ModerationTypeHideAvatar, UserID: "usr_h2"},
+		{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeMute, UserID: "usr_m1"},
+		{Action: shared.ModerationActionUnmoderate, Type: shared.ModerationTypeMute, UserID: "usr_m2"},
+	}
+	// map の反復順に依存しないことを複数回の実行で確認する
+	for i := 0; i < 20; i++ {
+		got, err := c.SyncPlayerModerations(context.Background(), desired, shared.SyncPlayerModerationsOptions{DryRun: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("run %d: SyncPlayerModerations() = %v, want %v", i, got, want)
+		}
+	}
+	if writes != 0 {
+		t.Errorf("dry run issued %d write requests", writes)
+	}
+}
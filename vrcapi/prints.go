@@ -0,0 +1,116 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"iter"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// printsPageSize はプリントのイテレータで1回に取得する件数です
+const printsPageSize = 100
+
+// GetUserPrints は指定されたユーザーのプリントのリストを取得します
+func (c *Client) GetUserPrints(ctx context.Context, userID string, n, offset int) ([]shared.Print, error) {
+	var prints []shared.Print
+	path := fmt.Sprintf("/prints/user/%s?n=%d&offset=%d", userID, n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &prints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user prints: %w", err)
+	}
+	return prints, nil
+}
+
+// AllUserPrints は指定されたユーザーのすべてのプリントを順に返すイテレータです
+func (c *Client) AllUserPrints(ctx context.Context, userID string) iter.Seq2[shared.Print, error] {
+	return paginate(ctx, printsPageSize, func(ctx context.Context, n, offset int) ([]shared.Print, error) {
+		return c.GetUserPrints(ctx, userID, n, offset)
+	})
+}
+
+// GetPrint は指定されたプリントを取得します
+func (c *Client) GetPrint(ctx context.Context, printID string) (*shared.Print, error) {
+	var p shared.Print
+	err := c.doRequest(ctx, "GET", "/prints/"+printID, nil, &p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get print: %w", err)
+	}
+	return &p, nil
+}
+
+// DeletePrint は指定されたプリントを削除します
+func (c *Client) DeletePrint(ctx context.Context, printID string) error {
+	err := c.doRequest(ctx, "DELETE", "/prints/"+printID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete print: %w", err)
+	}
+	return nil
+}
+
+// UploadPrint はプリントをアップロードします
+func (c *Client) UploadPrint(ctx context.Context, r io.Reader, opts shared.UploadPrintOptions) (*shared.Print, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxImageSize {
+		return nil, fmt.Errorf("invalid image: larger than %d bytes", maxImageSize)
+	}
+	_, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = "print." + format
+	}
+	timestamp := opts.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	fields := [][2]string{{"timestamp", timestamp.UTC().Format(time.RFC3339)}}
+	if opts.Note != "" {
+		fields = append(fields, [2]string{"note", opts.Note})
+	}
+	if opts.WorldID != "" {
+		fields = append(fields, [2]string{"worldId", opts.WorldID})
+	}
+	if opts.WorldName != "" {
+		fields = append(fields, [2]string{"worldName", opts.WorldName})
+	}
+	for _, field := range fields {
+		if err := form.WriteField(field[0], field[1]); err != nil {
+			return nil, fmt.Errorf("failed to build form: %w", err)
+		}
+	}
+	part, err := form.CreateFormFile("image", fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+
+	var p shared.Print
+	err = c.doPayload(ctx, "POST", "/prints", buf.Bytes(), &p, func(req *http.Request) {
+		req.Header.Set("Content-Type", form.FormDataContentType())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload print: %w", err)
+	}
+	return &p, nil
+}
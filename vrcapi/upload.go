@@ -0,0 +1,309 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+	"github.com/kqnade/vrcgo/vrcrsync"
+)
+
+const (
+	// defaultUploadPartSize はマルチパートアップロードのデフォルトのパートサイズです
+	defaultUploadPartSize = 10 * 1024 * 1024
+	// defaultUploadPollInterval はアップロード完了後の処理状況確認のデフォルト間隔です
+	defaultUploadPollInterval = 2 * time.Second
+
+	// ファイルの状態
+	fileStatusWaiting  = "waiting"
+	fileStatusComplete = "complete"
+	fileStatusError    = "error"
+
+	// アップロード方式
+	uploadCategoryMultipart = "multipart"
+
+	// signatureMimeType は署名ファイルのContent-Typeです
+	signatureMimeType = "application/x-rsync-signature"
+)
+
+// UploadFile はファイルの新しいバージョンを作成し、ファイル本体と署名をアップロードします。
+// 署名を省略した場合は vrcrsync で librsync 形式の署名を生成します。
+// 同じ内容で待機中のバージョンが既にある場合は、そのバージョンのアップロードを途中から再開します。
+// アップロード後はサーバー側の処理が完了するまで待機し、最新のファイル情報を返します。
+func (c *Client) UploadFile(ctx context.Context, fileID string, r io.ReaderAt, size int64, opts shared.UploadFileOptions) (*shared.File, error) {
+	// 署名が指定されていない場合は librsync 形式の署名を生成
+	if opts.Signature == nil {
+		signature, err := vrcrsync.Signature(io.NewSectionReader(r, 0, size), vrcrsync.Options{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate signature: %w", err)
+		}
+		opts.Signature = bytes.NewReader(signature)
+		opts.SignatureSize = int64(len(signature))
+	}
+
+	fileMD5, err := md5Base64(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute file md5: %w", err)
+	}
+	signatureMD5, err := md5Base64(opts.Signature, opts.SignatureSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute signature md5: %w", err)
+	}
+
+	file, err := c.GetFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	version := latestFileVersion(file)
+	if !isResumableVersion(version, fileMD5, signatureMD5) {
+		req := shared.CreateFileVersionRequest{
+			SignatureMD5:         signatureMD5,
+			SignatureSizeInBytes: opts.SignatureSize,
+			FileMD5:              fileMD5,
+			FileSizeInBytes:      size,
+		}
+		file, err = c.CreateFileVersion(ctx, fileID, req)
+		if err != nil {
+			return nil, err
+		}
+		version = latestFileVersion(file)
+		if version == nil || version.File == nil || version.Signature == nil {
+			return nil, fmt.Errorf("failed to upload file: created version has no upload targets")
+		}
+	}
+
+	mimeType := opts.MimeType
+	if mimeType == "" {
+		mimeType = file.MimeType
+	}
+
+	if err := c.uploadFilePart(ctx, fileID, version.Version, shared.FileDataTypeFile, version.File, r, size, fileMD5, mimeType, opts); err != nil {
+		return nil, err
+	}
+	if err := c.uploadFilePart(ctx, fileID, version.Version, shared.FileDataTypeSignature, &version.Signature.FileDescriptor, opts.Signature, opts.SignatureSize, signatureMD5, signatureMimeType, opts); err != nil {
+		return nil, err
+	}
+
+	return c.waitForFileVersion(ctx, fileID, version.Version, opts.PollInterval)
+}
+
+// uploadFilePart はファイル本体または署名をアップロードし、アップロードを完了させます
+func (c *Client) uploadFilePart(ctx context.Context, fileID string, versionID int, fileType shared.FileDataType, descriptor *shared.FileDescriptor, r io.ReaderAt, size int64, md5sum, mimeType string, opts shared.UploadFileOptions) error {
+	if descriptor.Status == fileStatusComplete {
+		c.reportUploadProgress(opts, fileType, size, size)
+		return nil
+	}
+
+	var etags []string
+	if descriptor.Category == uploadCategoryMultipart {
+		var err error
+		etags, err = c.uploadMultipart(ctx, fileID, versionID, fileType, r, size, mimeType, opts)
+		if err != nil {
+			return err
+		}
+	} else {
+		uploadURL, err := c.StartFileDataUpload(ctx, fileID, versionID, fileType, 0)
+		if err != nil {
+			return err
+		}
+		progress := func(n int64) { c.reportUploadProgress(opts, fileType, n, size) }
+		if _, err := c.putObject(ctx, uploadURL.URL, io.NewSectionReader(r, 0, size), size, mimeType, md5sum, progress); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", fileType, err)
+		}
+	}
+
+	req := shared.FinishFileDataUploadRequest{
+		ETags:          etags,
+		NextPartNumber: "0",
+		MaxParts:       "0",
+	}
+	if _, err := c.FinishFileDataUpload(ctx, fileID, versionID, fileType, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// uploadMultipart はパートごとにアップロードし、全パートのETagを返します。
+// アップロード済みのパートがある場合はその続きから再開します。
+func (c *Client) uploadMultipart(ctx context.Context, fileID string, versionID int, fileType shared.FileDataType, r io.ReaderAt, size int64, mimeType string, opts shared.UploadFileOptions) ([]string, error) {
+	partSize := opts.PartSize
+	if partSize <= 0 {
+		partSize = defaultUploadPartSize
+	}
+
+	// アップロード済みのパートを確認（未開始の場合はクライアントエラーが返る）
+	var etags []string
+	status, err := c.GetFileDataUploadStatus(ctx, fileID, versionID, fileType)
+	var apiErr *shared.APIError
+	switch {
+	case err == nil:
+		etags = status.ETags
+	case errors.As(err, &apiErr) && apiErr.StatusCode < 500:
+	default:
+		return nil, err
+	}
+
+	// 再開時は前回のパートサイズで分割しないとバイト範囲がずれるため、サーバー上のパートのサイズに合わせる。
+	// サイズが分からない場合は最初からアップロードし直す（同じパート番号は上書きされる）
+	if len(etags) > 0 {
+		if uploaded := status.PartSize(); uploaded > 0 {
+			partSize = uploaded
+		} else {
+			etags = nil
+		}
+	}
+
+	parts := int((size + partSize - 1) / partSize)
+	if parts == 0 {
+		parts = 1
+	}
+	if len(etags) > parts {
+		etags = nil
+	}
+
+	for part := len(etags); part < parts; part++ {
+		offset := int64(part) * partSize
+		length := min(partSize, size-offset)
+
+		uploadURL, err := c.StartFileDataUpload(ctx, fileID, versionID, fileType, part+1)
+		if err != nil {
+			return nil, err
+		}
+		progress := func(n int64) { c.reportUploadProgress(opts, fileType, offset+n, size) }
+		etag, err := c.putObject(ctx, uploadURL.URL, io.NewSectionReader(r, offset, length), length, mimeType, "", progress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload %s part %d: %w", fileType, part+1, err)
+		}
+		etags = append(etags, etag)
+	}
+	return etags, nil
+}
+
+// putObject は署名付きURLにデータをPUTし、レスポンスのETagを返します
+func (c *Client) putObject(ctx context.Context, uploadURL string, body io.Reader, size int64, mimeType, md5sum string, progress func(n int64)) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "PUT", uploadURL, &progressReader{r: body, fn: progress})
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("User-Agent", c.userAgent)
+	if mimeType != "" {
+		req.Header.Set("Content-Type", mimeType)
+	}
+	if md5sum != "" {
+		req.Header.Set("Content-MD5", md5sum)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 400 {
+		return "", &shared.APIError{
+			StatusCode: resp.StatusCode,
+			Message:    resp.Status,
+		}
+	}
+	return resp.Header.Get("ETag"), nil
+}
+
+// waitForFileVersion はファイルバージョンの処理が完了するまで待機します
+func (c *Client) waitForFileVersion(ctx context.Context, fileID string, versionID int, interval time.Duration) (*shared.File, error) {
+	if interval <= 0 {
+		interval = defaultUploadPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		file, err := c.GetFile(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range file.Versions {
+			if version.Version != versionID {
+				continue
+			}
+			switch version.Status {
+			case fileStatusComplete:
+				return file, nil
+			case fileStatusError:
+				return nil, fmt.Errorf("failed to upload file: version %d is in error state", versionID)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// reportUploadProgress は進捗を OnProgress に通知します
+func (c *Client) reportUploadProgress(opts shared.UploadFileOptions, fileType shared.FileDataType, uploaded, total int64) {
+	if opts.OnProgress != nil {
+		opts.OnProgress(shared.UploadProgress{
+			FileType:      fileType,
+			UploadedBytes: uploaded,
+			TotalBytes:    total,
+		})
+	}
+}
+
+// latestFileVersion は最新のファイルバージョンを返します
+func latestFileVersion(file *shared.File) *shared.FileVersion {
+	var latest *shared.FileVersion
+	for i := range file.Versions {
+		if latest == nil || file.Versions[i].Version > latest.Version {
+			latest = &file.Versions[i]
+		}
+	}
+	return latest
+}
+
+// isResumableVersion はバージョンが同じ内容のアップロード待ちであるかを判定します
+func isResumableVersion(version *shared.FileVersion, fileMD5, signatureMD5 string) bool {
+	return version != nil &&
+		version.Version > 0 &&
+		version.Status == fileStatusWaiting &&
+		version.File != nil && version.File.MD5 == fileMD5 &&
+		version.Signature != nil && version.Signature.MD5 == signatureMD5
+}
+
+// md5Base64 は r の先頭 size バイトのMD5をBase64で返します
+func md5Base64(r io.ReaderAt, size int64) (string, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, io.NewSectionReader(r, 0, size)); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// progressReader は読み込んだバイト数を通知する io.Reader です
+type progressReader struct {
+	r  io.Reader
+	n  int64
+	fn func(n int64)
+}
+
+// Read は読み込みを行い、進捗を通知します
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.n += int64(n)
+		p.fn(p.n)
+	}
+	return n, err
+}
@@ -0,0 +1,350 @@
+package vrcapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+// fakeFileStore はファイルAPIと署名付きURLのアップロード先を模擬するサーバーです
+type fakeFileStore struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	srv      *httptest.Server
+	category string // ファイル本体のアップロード方式
+	noSizes  bool   // status でパートのサイズを返さない
+	version  *shared.FileVersion
+	parts    map[shared.FileDataType]map[int][]byte
+	finished map[shared.FileDataType][]string // finish で受け取ったETag
+	puts     map[shared.FileDataType][]int    // PUTされたパート番号
+}
+
+func newFakeFileStore(t *testing.T, category string) *fakeFileStore {
+	s := &fakeFileStore{
+		t:        t,
+		category: category,
+		parts:    map[shared.FileDataType]map[int][]byte{},
+		finished: map[shared.FileDataType][]string{},
+		puts:     map[shared.FileDataType][]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /file/{id}", s.getFile)
+	mux.HandleFunc("POST /file/{id}", s.createVersion)
+	mux.HandleFunc("PUT /file/{id}/{version}/{type}/start", s.start)
+	mux.HandleFunc("GET /file/{id}/{version}/{type}/status", s.status)
+	mux.HandleFunc("PUT /file/{id}/{version}/{type}/finish", s.finish)
+	mux.HandleFunc("PUT /upload/{type}/{part}", s.put)
+	s.srv = httptest.NewServer(mux)
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *fakeFileStore) client() *Client {
+	c, err := NewClient(WithBaseURL(s.srv.URL))
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return c
+}
+
+// resumeFrom は同じ内容で待機中のバージョンと、アップロード済みのファイル本体のパートを用意します
+func (s *fakeFileStore) resumeFrom(data, signature []byte, uploaded ...[]byte) {
+	s.version = s.newVersion(md5Sum(data), md5Sum(signature))
+	s.parts[shared.FileDataTypeFile] = map[int][]byte{}
+	for i, part := range uploaded {
+		s.parts[shared.FileDataTypeFile][i+1] = part
+	}
+}
+
+func (s *fakeFileStore) newVersion(fileMD5, signatureMD5 string) *shared.FileVersion {
+	return &shared.FileVersion{
+		Version: 1,
+		Status:  fileStatusWaiting,
+		File: &shared.FileDescriptor{
+			Category: s.category,
+			Status:   fileStatusWaiting,
+			MD5:      fileMD5,
+		},
+		Signature: &shared.FileVersionSignature{
+			FileDescriptor: shared.FileDescriptor{
+				Category: "simple",
+				Status:   fileStatusWaiting,
+				MD5:      signatureMD5,
+			},
+		},
+	}
+}
+
+func (s *fakeFileStore) getFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file := shared.File{ID: r.PathValue("id"), MimeType: "application/octet-stream"}
+	file.Versions = append(file.Versions, shared.FileVersion{Version: 0, Status: fileStatusComplete})
+	if s.version != nil {
+		file.Versions = append(file.Versions, *s.version)
+	}
+	writeJSON(w, file)
+}
+
+func (s *fakeFileStore) createVersion(w http.ResponseWriter, r *http.Request) {
+	var req shared.CreateFileVersionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.version = s.newVersion(req.FileMD5, req.SignatureMD5)
+	s.mu.Unlock()
+	s.getFile(w, r)
+}
+
+func (s *fakeFileStore) start(w http.ResponseWriter, r *http.Request) {
+	part := r.URL.Query().Get("partNumber")
+	if part == "" {
+		part = "0"
+	}
+	writeJSON(w, shared.FileUploadURL{URL: fmt.Sprintf("%s/upload/%s/%s", s.srv.URL, r.PathValue("type"), part)})
+}
+
+func (s *fakeFileStore) status(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fileType := shared.FileDataType(r.PathValue("type"))
+	var status shared.FileVersionUploadStatus
+	for n := 1; ; n++ {
+		data, ok := s.parts[fileType][n]
+		if !ok {
+			break
+		}
+		etag := partETag(fileType, n)
+		status.ETags = append(status.ETags, etag)
+		if !s.noSizes {
+			status.Parts = append(status.Parts, shared.FileUploadPart{PartNumber: n, ETag: etag, Size: int64(len(data))})
+		}
+	}
+	status.NextPartNumber = len(status.ETags) + 1
+	writeJSON(w, status)
+}
+
+func (s *fakeFileStore) finish(w http.ResponseWriter, r *http.Request) {
+	var req shared.FinishFileDataUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	fileType := shared.FileDataType(r.PathValue("type"))
+	s.finished[fileType] = req.ETags
+	switch fileType {
+	case shared.FileDataTypeFile:
+		s.version.File.Status = fileStatusComplete
+	case shared.FileDataTypeSignature:
+		s.version.Signature.Status = fileStatusComplete
+	}
+	if s.version.File.Status == fileStatusComplete && s.version.Signature.Status == fileStatusComplete {
+		s.version.Status = fileStatusComplete
+	}
+	s.mu.Unlock()
+	s.getFile(w, r)
+}
+
+func (s *fakeFileStore) put(w http.ResponseWriter, r *http.Request) {
+	fileType := shared.FileDataType(r.PathValue("type"))
+	part, _ := strconv.Atoi(r.PathValue("part"))
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if sum := r.Header.Get("Content-MD5"); sum != "" && sum != md5Sum(data) {
+		http.Error(w, "md5 mismatch", http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	if s.parts[fileType] == nil {
+		s.parts[fileType] = map[int][]byte{}
+	}
+	s.parts[fileType][part] = data
+	s.puts[fileType] = append(s.puts[fileType], part)
+	s.mu.Unlock()
+	w.Header().Set("ETag", partETag(fileType, part))
+}
+
+// object はアップロードされたパートを番号順に連結した内容を返します
+func (s *fakeFileStore) object(fileType shared.FileDataType) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var numbers []int
+	for n := range s.parts[fileType] {
+		numbers = append(numbers, n)
+	}
+	slices.Sort(numbers)
+	var buf bytes.Buffer
+	for _, n := range numbers {
+		buf.Write(s.parts[fileType][n])
+	}
+	return buf.Bytes()
+}
+
+func partETag(fileType shared.FileDataType, part int) string {
+	return fmt.Sprintf(`"%s-%d"`, fileType, part)
+}
+
+func md5Sum(data []byte) string {
+	sum := md5.Sum(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func testUploadData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	return data
+}
+
+func TestUploadFileSimple(t *testing.T) {
+	store := newFakeFileStore(t, "simple")
+	data := testUploadData(1000)
+
+	var last shared.UploadProgress
+	file, err := store.client().UploadFile(context.Background(), "file_test", bytes.NewReader(data), int64(len(data)), shared.UploadFileOptions{
+		PollInterval: 10 * time.Millisecond,
+		OnProgress: func(p shared.UploadProgress) {
+			if p.FileType == shared.FileDataTypeFile {
+				last = p
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := latestFileVersion(file); got.Version != 1 || got.Status != fileStatusComplete {
+		t.Errorf("latest version = %d (%s), want 1 (complete)", got.Version, got.Status)
+	}
+	if !bytes.Equal(store.object(shared.FileDataTypeFile), data) {
+		t.Error("uploaded file does not match input")
+	}
+	if len(store.object(shared.FileDataTypeSignature)) == 0 {
+		t.Error("signature was not uploaded")
+	}
+	if got := store.finished[shared.FileDataTypeFile]; len(got) != 0 {
+		t.Errorf("simple upload finished with etags %v", got)
+	}
+	if last.UploadedBytes != int64(len(data)) || last.TotalBytes != int64(len(data)) {
+		t.Errorf("last progress = %+v, want %d/%d", last, len(data), len(data))
+	}
+}
+
+func TestUploadFileMultipart(t *testing.T) {
+	store := newFakeFileStore(t, uploadCategoryMultipart)
+	data := testUploadData(23)
+
+	_, err := store.client().UploadFile(context.Background(), "file_test", bytes.NewReader(data), int64(len(data)), shared.UploadFileOptions{
+		PartSize:     10,
+		PollInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(store.object(shared.FileDataTypeFile), data) {
+		t.Error("uploaded file does not match input")
+	}
+	if got, want := store.puts[shared.FileDataTypeFile], []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("uploaded parts = %v, want %v", got, want)
+	}
+	want := []string{partETag("file", 1), partETag("file", 2), partETag("file", 3)}
+	if got := store.finished[shared.FileDataTypeFile]; !slices.Equal(got, want) {
+		t.Errorf("finish etags = %v, want %v", got, want)
+	}
+}
+
+func TestUploadFileMultipartResume(t *testing.T) {
+	data := testUploadData(23)
+	signature := []byte("signature")
+
+	tests := []struct {
+		name     string
+		partSize int64
+	}{
+		{name: "same part size", partSize: 8},
+		// 前回と異なるパートサイズが指定されてもサーバー上のパートサイズで続きを分割する
+		{name: "different part size", partSize: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeFileStore(t, uploadCategoryMultipart)
+			store.resumeFrom(data, signature, data[:8], data[8:16])
+
+			_, err := store.client().UploadFile(context.Background(), "file_test", bytes.NewReader(data), int64(len(data)), shared.UploadFileOptions{
+				Signature:     bytes.NewReader(signature),
+				SignatureSize: int64(len(signature)),
+				PartSize:      tt.partSize,
+				PollInterval:  10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := store.puts[shared.FileDataTypeFile], []int{3}; !slices.Equal(got, want) {
+				t.Errorf("uploaded parts = %v, want %v", got, want)
+			}
+			if !bytes.Equal(store.object(shared.FileDataTypeFile), data) {
+				t.Error("resumed file does not match input")
+			}
+			want := []string{partETag("file", 1), partETag("file", 2), partETag("file", 3)}
+			if got := store.finished[shared.FileDataTypeFile]; !slices.Equal(got, want) {
+				t.Errorf("finish etags = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUploadFileMultipartRestartsWithoutPartSize(t *testing.T) {
+	data := testUploadData(23)
+	signature := []byte("signature")
+	store := newFakeFileStore(t, uploadCategoryMultipart)
+	store.resumeFrom(data, signature, data[:8])
+
+	// パートのサイズを返さないサーバーでは続きから再開できないため最初からアップロードし直す
+	store.noSizes = true
+
+	_, err := store.client().UploadFile(context.Background(), "file_test", bytes.NewReader(data), int64(len(data)), shared.UploadFileOptions{
+		Signature:     bytes.NewReader(signature),
+		SignatureSize: int64(len(signature)),
+		PartSize:      10,
+		PollInterval:  10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := store.puts[shared.FileDataTypeFile], []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("uploaded parts = %v, want %v", got, want)
+	}
+	if !bytes.Equal(store.object(shared.FileDataTypeFile), data) {
+		t.Error("restarted file does not match input")
+	}
+}
@@ -78,3 +78,60 @@ func (c *Client) GetUserGroups(ctx context.Context, userID string) ([]shared.Use
 	}
 	return groups, nil
 }
+
+// GetUserRepresentedGroup は指定されたユーザーが代表に設定しているグループを取得します
+func (c *Client) GetUserRepresentedGroup(ctx context.Context, userID string) (*shared.UserGroup, error) {
+	var group shared.UserGroup
+	err := c.doRequest(ctx, "GET", "/users/"+userID+"/groups/represented", nil, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user represented group: %w", err)
+	}
+	return &group, nil
+}
+
+// GetUserGroupRequests は指定されたユーザーが参加をリクエスト中のグループのリストを取得します
+func (c *Client) GetUserGroupRequests(ctx context.Context, userID string) ([]shared.Group, error) {
+	var groups []shared.Group
+	err := c.doRequest(ctx, "GET", "/users/"+userID+"/groups/requested", nil, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user group requests: %w", err)
+	}
+	return groups, nil
+}
+
+// GetUserNotes はユーザーに付けたメモのリストを取得します
+func (c *Client) GetUserNotes(ctx context.Context, n, offset int) ([]shared.UserNote, error) {
+	var notes []shared.UserNote
+	path := fmt.Sprintf("/userNotes?n=%d&offset=%d", n, offset)
+	err := c.doRequest(ctx, "GET", path, nil, &notes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user notes: %w", err)
+	}
+	return notes, nil
+}
+
+// UpdateUserNote はユーザーにメモを付けます。空文字列を指定するとメモを削除します。
+func (c *Client) UpdateUserNote(ctx context.Context, targetUserID, note string) (*shared.UserNote, error) {
+	var userNote shared.UserNote
+	req := shared.UpdateUserNoteRequest{TargetUserID: targetUserID, Note: note}
+	err := c.doRequest(ctx, "POST", "/userNotes", req, &userNote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user note: %w", err)
+	}
+	// User.Note に反映されるため対象ユーザーのキャッシュを破棄
+	c.InvalidateCache("/users/" + targetUserID)
+	return &userNote, nil
+}
+
+// ReportUser はユーザーのプロフィールや行動を報告します
+func (c *Client) ReportUser(ctx context.Context, userID string, req shared.ReportUserRequest) (*shared.ModerationReport, error) {
+	req.Type = "user"
+	req.ContentID = userID
+
+	var report shared.ModerationReport
+	err := c.doRequest(ctx, "POST", "/moderationReports", req, &report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to report user: %w", err)
+	}
+	return &report, nil
+}
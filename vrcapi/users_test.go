@@ -0,0 +1,112 @@
+package vrcapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestReportUser(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.ModerationReport{ID: "mrpt_1", Type: "user", ContentID: "usr_1"})
+	report, err := c.ReportUser(context.Background(), "usr_1", shared.ReportUserRequest{
+		// Type と ContentID は呼び出し側の値に関わらず上書きされる
+		Type:        "world",
+		ContentID:   "wrld_1",
+		Category:    shared.ReportCategoryProfile,
+		Reason:      shared.ReportReasonImpersonation,
+		Description: "pretends to be someone else",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.ID != "mrpt_1" {
+		t.Errorf("ReportUser() = %+v", report)
+	}
+	req := (*requests)[0]
+	if req.Method != "POST" || req.Path != "/moderationReports" {
+		t.Errorf("request = %s %s, want POST /moderationReports", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{
+		"type":        `"user"`,
+		"contentId":   `"usr_1"`,
+		"category":    `"profile"`,
+		"reason":      `"impersonation"`,
+		"description": `"pretends to be someone else"`,
+	})
+}
+
+func TestUpdateUserNoteInvalidatesUser(t *testing.T) {
+	var mu sync.Mutex
+	note := "old"
+	userGets := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/users/usr_1":
+			userGets++
+			writeJSON(w, shared.User{ID: "usr_1", Note: note})
+		case r.Method == "POST" && r.URL.Path == "/userNotes":
+			var req shared.UpdateUserNoteRequest
+			data, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(data, &req); err != nil {
+				t.Error(err)
+			}
+			note = req.Note
+			writeJSON(w, shared.UserNote{ID: "unt_1", TargetUserID: req.TargetUserID, Note: req.Note})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(WithBaseURL(srv.URL), WithCache(NewLRUCache(10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := c.GetUser(ctx, "usr_1"); err != nil {
+		t.Fatal(err)
+	}
+	// 空文字列でメモを削除する
+	userNote, err := c.UpdateUserNote(ctx, "usr_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userNote.TargetUserID != "usr_1" || userNote.Note != "" {
+		t.Errorf("UpdateUserNote() = %+v", userNote)
+	}
+
+	// メモの更新後はキャッシュではなくサーバーから取得し直す
+	user, err := c.GetUser(ctx, "usr_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Note != "" || userGets != 2 {
+		t.Errorf("GetUser() note = %q after %d requests, want empty note after 2", user.Note, userGets)
+	}
+}
+
+func TestUpdateUserExtendedFields(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.CurrentUser{ID: "usr_1"})
+	pronouns := "they/them"
+	booping := false
+	_, err := c.UpdateUser(context.Background(), "usr_1", shared.UpdateUserRequest{
+		Pronouns:         &pronouns,
+		IsBoopingEnabled: &booping,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != "PUT" || req.Path != "/users/usr_1" {
+		t.Errorf("request = %s %s, want PUT /users/usr_1", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{"pronouns": `"they/them"`, "isBoopingEnabled": `false`})
+}
@@ -21,6 +21,42 @@ func (c *Client) GetWorld(ctx context.Context, worldID string) (*shared.World, e
 
 // SearchWorlds はワールドを検索します
 func (c *Client) SearchWorlds(ctx context.Context, opts shared.SearchWorldsOptions) ([]shared.LimitedWorld, error) {
+	worlds, err := c.listWorlds(ctx, "/worlds", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search worlds: %w", err)
+	}
+	return worlds, nil
+}
+
+// GetActiveWorlds は現在アクティブなワールドのリストを取得します
+func (c *Client) GetActiveWorlds(ctx context.Context, opts shared.SearchWorldsOptions) ([]shared.LimitedWorld, error) {
+	worlds, err := c.listWorlds(ctx, "/worlds/active", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active worlds: %w", err)
+	}
+	return worlds, nil
+}
+
+// GetRecentWorlds は最近訪問したワールドのリストを取得します
+func (c *Client) GetRecentWorlds(ctx context.Context, opts shared.SearchWorldsOptions) ([]shared.LimitedWorld, error) {
+	worlds, err := c.listWorlds(ctx, "/worlds/recent", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent worlds: %w", err)
+	}
+	return worlds, nil
+}
+
+// GetFavoriteWorlds はお気に入りに登録したワールドのリストを取得します
+func (c *Client) GetFavoriteWorlds(ctx context.Context, opts shared.SearchWorldsOptions) ([]shared.LimitedWorld, error) {
+	worlds, err := c.listWorlds(ctx, "/worlds/favorites", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite worlds: %w", err)
+	}
+	return worlds, nil
+}
+
+// listWorlds はワールド検索のオプションでワールドのリストを取得します
+func (c *Client) listWorlds(ctx context.Context, path string, opts shared.SearchWorldsOptions) ([]shared.LimitedWorld, error) {
 	params := url.Values{}
 	if opts.Featured {
 		params.Set("featured", "true")
@@ -62,14 +98,86 @@ func (c *Client) SearchWorlds(ctx context.Context, opts shared.SearchWorldsOptio
 	}
 
 	var worlds []shared.LimitedWorld
-	path := "/worlds"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+	err := c.doRequest(ctx, "GET", path+"?"+params.Encode(), nil, &worlds)
+	if err != nil {
+		return nil, err
 	}
+	return worlds, nil
+}
 
-	err := c.doRequest(ctx, "GET", path, nil, &worlds)
+// CreateWorld はワールドを作成します
+func (c *Client) CreateWorld(ctx context.Context, req shared.WorldRequest) (*shared.World, error) {
+	var world shared.World
+	err := c.doRequest(ctx, "POST", "/worlds", req, &world)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search worlds: %w", err)
+		return nil, fmt.Errorf("failed to create world: %w", err)
 	}
-	return worlds, nil
+	return &world, nil
+}
+
+// UpdateWorld はワールド情報を更新します
+func (c *Client) UpdateWorld(ctx context.Context, worldID string, req shared.UpdateWorldRequest) (*shared.World, error) {
+	var world shared.World
+	err := c.doRequest(ctx, "PUT", "/worlds/"+worldID, req, &world)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update world: %w", err)
+	}
+	return &world, nil
+}
+
+// DeleteWorld はワールドを削除します
+func (c *Client) DeleteWorld(ctx context.Context, worldID string) error {
+	err := c.doRequest(ctx, "DELETE", "/worlds/"+worldID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete world: %w", err)
+	}
+	return nil
+}
+
+// GetWorldPublishStatus はワールドをコミュニティラボに公開できるかを取得します
+func (c *Client) GetWorldPublishStatus(ctx context.Context, worldID string) (*shared.WorldPublishStatus, error) {
+	var status shared.WorldPublishStatus
+	err := c.doRequest(ctx, "GET", "/worlds/"+worldID+"/publish", nil, &status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world publish status: %w", err)
+	}
+	return &status, nil
+}
+
+// PublishWorld はワールドをコミュニティラボに公開します
+func (c *Client) PublishWorld(ctx context.Context, worldID string) error {
+	err := c.doRequest(ctx, "PUT", "/worlds/"+worldID+"/publish", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to publish world: %w", err)
+	}
+	return nil
+}
+
+// UnpublishWorld はワールドの公開を取り消します
+func (c *Client) UnpublishWorld(ctx context.Context, worldID string) error {
+	err := c.doRequest(ctx, "DELETE", "/worlds/"+worldID+"/publish", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to unpublish world: %w", err)
+	}
+	return nil
+}
+
+// GetWorldMetadata はワールドのメタデータを取得します
+func (c *Client) GetWorldMetadata(ctx context.Context, worldID string) (*shared.WorldMetadata, error) {
+	var metadata shared.WorldMetadata
+	err := c.doRequest(ctx, "GET", "/worlds/"+worldID+"/metadata", nil, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world metadata: %w", err)
+	}
+	return &metadata, nil
+}
+
+// GetWorldInstance はワールドの指定されたインスタンスの情報を取得します
+func (c *Client) GetWorldInstance(ctx context.Context, worldID, instanceID string) (*shared.Instance, error) {
+	var instance shared.Instance
+	err := c.doRequest(ctx, "GET", "/worlds/"+worldID+"/"+instanceID, nil, &instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world instance: %w", err)
+	}
+	return &instance, nil
 }
@@ -0,0 +1,77 @@
+package vrcapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kqnade/vrcgo/shared"
+)
+
+func TestCreateWorld(t *testing.T) {
+	c, requests := newRecordingServer(t, shared.World{ID: "wrld_1"})
+	_, err := c.CreateWorld(context.Background(), shared.WorldRequest{
+		Name:         "World",
+		AssetURL:     "https://example.com/world.vrcw",
+		Capacity:     16,
+		Platform:     "standalonewindows",
+		ImageURL:     "https://example.com/image.png",
+		AuthorID:     "usr_1",
+		Tags:         []string{"system_approved"},
+		AssetVersion: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != "POST" || req.Path != "/worlds" {
+		t.Errorf("request = %s %s, want POST /worlds", req.Method, req.Path)
+	}
+	assertBody(t, req.Body, map[string]string{
+		"name":         `"World"`,
+		"assetUrl":     `"https://example.com/world.vrcw"`,
+		"capacity":     `16`,
+		"platform":     `"standalonewindows"`,
+		"imageUrl":     `"https://example.com/image.png"`,
+		"authorId":     `"usr_1"`,
+		"tags":         `["system_approved"]`,
+		"assetVersion": `4`,
+	})
+}
+
+func TestUpdateWorld(t *testing.T) {
+	name := "Renamed"
+	capacity := 32
+	empty := ""
+	noTags := []string{}
+
+	tests := []struct {
+		name string
+		req  shared.UpdateWorldRequest
+		want map[string]string
+	}{
+		{
+			// 指定したフィールドだけを送る
+			name: "partial",
+			req:  shared.UpdateWorldRequest{Name: &name, Capacity: &capacity},
+			want: map[string]string{"name": `"Renamed"`, "capacity": `32`},
+		},
+		{
+			name: "clear fields",
+			req:  shared.UpdateWorldRequest{Description: &empty, Tags: &noTags},
+			want: map[string]string{"description": `""`, "tags": `[]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newRecordingServer(t, shared.World{ID: "wrld_1"})
+			if _, err := c.UpdateWorld(context.Background(), "wrld_1", tt.req); err != nil {
+				t.Fatal(err)
+			}
+			req := (*requests)[0]
+			if req.Method != "PUT" || req.Path != "/worlds/wrld_1" {
+				t.Errorf("request = %s %s, want PUT /worlds/wrld_1", req.Method, req.Path)
+			}
+			assertBody(t, req.Body, tt.want)
+		})
+	}
+}
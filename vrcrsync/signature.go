@@ -0,0 +1,169 @@
+// Package vrcrsync は VRChat のファイルバージョンで使用される librsync 形式の署名を生成します。
+//
+// 生成される署名は librsync（rdiff signature）と互換性があり、
+// ヘッダー（マジックナンバー、ブロック長、強いチェックサムの長さ）に続いて
+// ブロックごとのローリングチェックサムと強いチェックサムが並びます。
+package vrcrsync
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/md4"
+)
+
+// Magic は署名ファイルの種類を示すマジックナンバーです
+type Magic uint32
+
+const (
+	// MD4SigMagic は MD4 を強いチェックサムに使用する署名です
+	MD4SigMagic Magic = 0x72730136
+	// BLAKE2SigMagic は BLAKE2b を強いチェックサムに使用する署名です
+	BLAKE2SigMagic Magic = 0x72730137
+)
+
+const (
+	// DefaultBlockLen はデフォルトのブロック長です
+	DefaultBlockLen = 2048
+
+	// md4SumLength は MD4 の最大の強いチェックサム長です
+	md4SumLength = md4.Size
+	// blake2SumLength は BLAKE2 の最大の強いチェックサム長です
+	blake2SumLength = blake2b.Size256
+
+	// rollsumCharOffset は librsync のローリングチェックサムで各バイトに加算される値です
+	rollsumCharOffset = 31
+)
+
+// Options は署名生成のオプションです
+type Options struct {
+	// Magic は強いチェックサムのアルゴリズムです（0 の場合は BLAKE2SigMagic）
+	Magic Magic
+	// BlockLen はブロック長です（0 の場合は DefaultBlockLen）
+	BlockLen uint32
+	// StrongLen は強いチェックサムの長さです（0 の場合はアルゴリズムの最大長）
+	StrongLen uint32
+}
+
+// withDefaults はデフォルト値を補完したオプションを返します
+func (o Options) withDefaults() (Options, error) {
+	if o.Magic == 0 {
+		o.Magic = BLAKE2SigMagic
+	}
+	if o.BlockLen == 0 {
+		o.BlockLen = DefaultBlockLen
+	}
+
+	var maxLen uint32
+	switch o.Magic {
+	case MD4SigMagic:
+		maxLen = md4SumLength
+	case BLAKE2SigMagic:
+		maxLen = blake2SumLength
+	default:
+		return o, fmt.Errorf("unsupported signature magic: %#x", uint32(o.Magic))
+	}
+	if o.StrongLen == 0 {
+		o.StrongLen = maxLen
+	}
+	if o.StrongLen > maxLen {
+		return o, fmt.Errorf("strong sum length %d exceeds maximum %d", o.StrongLen, maxLen)
+	}
+	return o, nil
+}
+
+// newStrongHash は強いチェックサムのハッシュを作成します
+func (o Options) newStrongHash() hash.Hash {
+	if o.Magic == MD4SigMagic {
+		return md4.New()
+	}
+	// librsync は鍵なしの 32 バイト出力の BLAKE2b を使用する
+	h, _ := blake2b.New256(nil)
+	return h
+}
+
+// WriteSignature は r の内容から署名を生成して w に書き込み、書き込んだバイト数を返します
+func WriteSignature(w io.Writer, r io.Reader, opts Options) (int64, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return 0, err
+	}
+
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+
+	header := make([]byte, 12)
+	binary.BigEndian.PutUint32(header[0:], uint32(opts.Magic))
+	binary.BigEndian.PutUint32(header[4:], opts.BlockLen)
+	binary.BigEndian.PutUint32(header[8:], opts.StrongLen)
+	if _, err := cw.Write(header); err != nil {
+		return cw.n, err
+	}
+
+	block := make([]byte, opts.BlockLen)
+	strong := opts.newStrongHash()
+	weak := make([]byte, 4)
+	for {
+		n, err := io.ReadFull(r, block)
+		if n > 0 {
+			binary.BigEndian.PutUint32(weak, WeakSum(block[:n]))
+			if _, err := cw.Write(weak); err != nil {
+				return cw.n, err
+			}
+
+			strong.Reset()
+			strong.Write(block[:n])
+			if _, err := cw.Write(strong.Sum(nil)[:opts.StrongLen]); err != nil {
+				return cw.n, err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return cw.n, fmt.Errorf("failed to read input: %w", err)
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return cw.n, err
+	}
+	return cw.n, nil
+}
+
+// Signature は r の内容から署名を生成して返します
+func Signature(r io.Reader, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := WriteSignature(&buf, r, opts); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WeakSum は librsync のローリングチェックサム（rollsum）を計算します
+func WeakSum(block []byte) uint32 {
+	var s1, s2 uint32
+	for _, b := range block {
+		s1 += uint32(b) + rollsumCharOffset
+		s2 += s1
+	}
+	return (s2&0xffff)<<16 | (s1 & 0xffff)
+}
+
+// countingWriter は書き込んだバイト数を数える io.Writer です
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write は書き込みを行い、バイト数を加算します
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,92 @@
+package vrcrsync
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testdata の署名は librsync の署名形式に従って input.txt から生成したものです。
+// rdiff（librsync 2.x）では以下のコマンドで同じ内容を再生成できます（-R rollsum が必要）。
+//
+//	rdiff signature -H blake2 -R rollsum -b 16 -S 32 input.txt blake2.sig
+//	rdiff signature -H blake2 -R rollsum -b 16 -S 8 input.txt blake2-s8.sig
+//	rdiff signature -H md4 -R rollsum -b 16 -S 16 input.txt md4.sig
+func TestSignatureGolden(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("testdata", "input.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		golden string
+		opts   Options
+	}{
+		{golden: "blake2.sig", opts: Options{Magic: BLAKE2SigMagic, BlockLen: 16}},
+		{golden: "blake2-s8.sig", opts: Options{Magic: BLAKE2SigMagic, BlockLen: 16, StrongLen: 8}},
+		{golden: "md4.sig", opts: Options{Magic: MD4SigMagic, BlockLen: 16}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Signature(bytes.NewReader(input), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("signature mismatch\ngot:  %x\nwant: %x", got, want)
+			}
+		})
+	}
+}
+
+func TestSignatureEmptyInput(t *testing.T) {
+	got, err := Signature(bytes.NewReader(nil), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 空の入力ではヘッダー（BLAKE2、ブロック長 2048、強いチェックサム 32 バイト）のみ
+	want := []byte{0x72, 0x73, 0x01, 0x37, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x20}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Signature(empty) = %x, want %x", got, want)
+	}
+}
+
+func TestSignatureInvalidOptions(t *testing.T) {
+	if _, err := Signature(bytes.NewReader(nil), Options{Magic: 0x72730146}); err == nil {
+		t.Error("Signature() with unsupported magic succeeded, want error")
+	}
+	if _, err := Signature(bytes.NewReader(nil), Options{Magic: MD4SigMagic, StrongLen: 17}); err == nil {
+		t.Error("Signature() with too long strong sum succeeded, want error")
+	}
+}
+
+func TestWeakSum(t *testing.T) {
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+
+	tests := []struct {
+		name  string
+		block []byte
+		want  uint32
+	}{
+		{name: "empty", block: nil, want: 0},
+		// s1 = (97+31)+(98+31)+(99+31) = 387, s2 = 128+257+387 = 772
+		{name: "abc", block: []byte("abc"), want: 0x03040183},
+		// s2 は 16 ビットで桁あふれする
+		{name: "all bytes", block: all, want: 0x3a009e80},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeakSum(tt.block); got != tt.want {
+				t.Errorf("WeakSum() = %#08x, want %#08x", got, tt.want)
+			}
+		})
+	}
+}